@@ -26,9 +26,13 @@ package generatorcmd
 import (
 	"flag"
 	"fmt"
+	"log"
 	"os"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/objectbox/objectbox-generator/v4/internal/generator"
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/model"
 )
 
 const defaultErrorCode = 2
@@ -41,16 +45,30 @@ type generatorCommand interface {
 }
 
 func Main(impl generatorCommand) {
-	clean, options := getArgs(impl)
-
-	var err error
-	if clean {
-		fmt.Printf("Removing ObjectBox bindings for %s\n", options.InPath)
-		err = generator.Clean(options.CodeGenerator, options.InPath)
-	} else {
-		fmt.Printf("Generating ObjectBox bindings for %s\n", options.InPath)
-		err = generator.Process(options)
-	}
+	clean, cleanOrphans, summaryFile, prof, options := getArgs(impl)
+
+	var err = prof.run(func() error {
+		if clean && cleanOrphans {
+			fmt.Printf("Removing orphaned ObjectBox bindings for %s\n", options.InPath)
+			return generator.CleanOrphans(options)
+		} else if clean {
+			fmt.Printf("Removing ObjectBox bindings for %s\n", options.InPath)
+			return generator.CleanWithOptions(options)
+		} else {
+			if options.Check {
+				fmt.Printf("Checking ObjectBox bindings for %s\n", options.InPath)
+			} else {
+				fmt.Printf("Generating ObjectBox bindings for %s\n", options.InPath)
+			}
+			if err := generator.Process(options); err != nil {
+				return err
+			}
+			if len(summaryFile) > 0 {
+				return writeModelSummary(options, summaryFile)
+			}
+			return nil
+		}
+	})
 
 	stopOnError(0, err)
 }
@@ -74,9 +92,22 @@ func showUsageAndExit(impl generatorCommand, a ...interface{}) {
 	os.Exit(1)
 }
 
-func getArgs(impl generatorCommand) (clean bool, options generator.Options) {
+// printSupportedTypes prints the source types of the given generator with the ObjectBox property types they map to
+func printSupportedTypes(gen generator.CodeGenerator) {
+	var writer = tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "SOURCE TYPE\tPROPERTY TYPE\tFLAGS")
+	for _, mapping := range gen.SupportedTypes() {
+		var flags = propertyFlagNames(mapping.Flags)
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", mapping.SourceType, model.PropertyTypeNames[mapping.PropertyType], strings.Join(flags, ", "))
+	}
+	writer.Flush()
+}
+
+func getArgs(impl generatorCommand) (clean bool, cleanOrphans bool, summaryFile string, prof profiling, options generator.Options) {
 	var printVersion bool
 	var printHelp bool
+	var listTypes bool
+	var verbose bool
 	flag.Usage = impl.ShowUsage
 	impl.ConfigureFlags()
 	flag.StringVar(&options.OutPath, "out", "", "output path for generated source files")
@@ -84,10 +115,29 @@ func getArgs(impl generatorCommand) (clean bool, options generator.Options) {
 	flag.StringVar(&options.ModelInfoFile, "model", "", "path to the model information persistence file (JSON)")
 	// TODO remove in v0.15.0 or later
 	flag.StringVar(&options.ModelInfoFile, "persist", "", "[DEPRECATED, use 'model'] path to the model information persistence file (JSON)")
+	flag.StringVar(&options.GeneratedBy, "generated-by", generator.DefaultGeneratedBy, "generator name used in the \"Code generated by ...; DO NOT EDIT.\" banner of the generated files")
+	flag.BoolVar(&options.EmitUnchanged, "emit-unchanged", false, "rewrite generated files even if their content hasn't changed")
+	flag.StringVar(&options.Suffix, "suffix", generator.DefaultSuffix, "inserted between the source file name and the extension of the generated binding files, e.g. \".gen\" generates \"entity.gen.go\"; use the same value when cleaning")
+	flag.StringVar(&options.LineEndings, "output-encoding", generator.LineEndingsLF, "line endings of the generated source files, regardless of the OS; one of: lf, crlf")
+	flag.BoolVar(&options.DryRun, "dry-run", false, "don't write or remove any files, only print which ones would be created, overwritten or removed")
+	flag.BoolVar(&options.Check, "check", false, "don't write or remove any files, fail if the generated files aren't up to date with the sources, e.g. in CI")
+	flag.IntVar(&options.Parallel, "parallel", 1, "number of source files parsed concurrently when generating for a directory or a pattern; the output doesn't depend on it")
+	flag.BoolVar(&verbose, "verbose", false, "print detailed diagnostics, e.g. IDs/UIDs assigned to new entities, properties and indexes, and the files written")
+	flag.BoolVar(&options.Strict, "strict", false, "treat warnings about a suspicious model as errors, e.g. an entity without any property besides the ID")
+	flag.StringVar(&summaryFile, "summary-json", "", "after generating, write a machine-readable summary of the model (entities, properties, types, UIDs, flags) to the given JSON file; it's not the model file used by the generator")
+	flag.BoolVar(&options.Force, "force", false, "remove files named like generated ones even if they don't start with the \"Code generated ... DO NOT EDIT.\" banner")
+	flag.BoolVar(&cleanOrphans, "orphans", false, "in combination with \"clean\": only remove generated files whose source file doesn't exist anymore")
+	flag.StringVar(&prof.cpuFile, "cpuprofile", "", "write a CPU profile of the generation to the given file")
+	flag.StringVar(&prof.memFile, "memprofile", "", "write a memory profile after the generation to the given file")
+	flag.BoolVar(&listTypes, "list-types", false, "print the source types supported by the selected generator and how they're stored")
 	flag.BoolVar(&printVersion, "version", false, "print the generator version info")
 	flag.BoolVar(&printHelp, "help", false, "print this help")
 	flag.Parse()
 
+	if verbose {
+		options.Logger = log.New(os.Stdout, "", 0)
+	}
+
 	if printHelp {
 		impl.ShowUsage()
 		os.Exit(0)
@@ -115,6 +165,11 @@ func getArgs(impl generatorCommand) (clean bool, options generator.Options) {
 		showUsageAndExit(impl, err)
 	}
 
+	if listTypes {
+		printSupportedTypes(options.CodeGenerator)
+		os.Exit(0)
+	}
+
 	if len(options.InPath) == 0 {
 		showUsageAndExit(impl, "path not specified")
 	}
@@ -123,5 +178,21 @@ func getArgs(impl generatorCommand) (clean bool, options generator.Options) {
 		showUsageAndExit(impl, "unknown arguments", args)
 	}
 
+	if clean && len(summaryFile) > 0 {
+		showUsageAndExit(impl, "argument -summary-json can't be used with \"clean\"")
+	}
+
+	if options.DryRun && len(summaryFile) > 0 {
+		showUsageAndExit(impl, "argument -summary-json can't be used with -dry-run")
+	}
+
+	if options.Check && len(summaryFile) > 0 {
+		showUsageAndExit(impl, "argument -summary-json can't be used with -check")
+	}
+
+	if options.Check && clean {
+		showUsageAndExit(impl, "argument -check can't be used with \"clean\"")
+	}
+
 	return
 }
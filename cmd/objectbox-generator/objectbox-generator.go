@@ -51,6 +51,13 @@ type command struct {
 	optional             *string
 	empty_string_as_null *bool // pointers due to flag API (https://pkg.go.dev/flag#Bool)
 	nan_as_null          *bool
+	vector_alignment     *int
+	accessors            *bool
+	equality             *bool
+	skip_deprecated      *bool
+	fbs_out              *string
+	package_name         *string
+	build_tag            *string
 }
 
 func (cmd command) ShowUsage() {
@@ -74,16 +81,23 @@ or
   objectbox-generator [flags] clean {path}
       to remove the generated files instead of creating them - this removes *.obx.* and objectbox-model.h but keeps objectbox-model.json
 
+
+or
+  objectbox-generator [flags] -orphans clean {path}
+      to remove only the generated files whose source file doesn't exist anymore
+
 or
   objectbox-generator FLATC [flatc arguments]
       to execute FlatBuffers flatc command line tool Any arguments after the FLATC keyword are passed through.
 
 path:
   * a source file path or a valid path pattern (e.g. ./...)
+
+Multiple output languages (e.g. -c -cpp) can be selected at once, sharing a single model (objectbox-model.json).
   
 Available flags:
 `)
-	flag.PrintDefaults()
+	generatorcmd.PrintDefaults()
 }
 
 func (cmd *command) ConfigureFlags() {
@@ -97,49 +111,119 @@ func (cmd *command) ConfigureFlags() {
 	cmd.optional = flag.String("optional", "", "C++ wrapper type to use for fields annotated \"optional\"; one of: std::optional, std::unique_ptr, std::shared_ptr")
 	cmd.empty_string_as_null = flag.Bool("empty-string-as-null", false, "C++: empty strings are treated as 0 (null)")
 	cmd.nan_as_null = flag.Bool("nan-as-null", false, "C++: NaNs are treated as 0 (null)")
+	cmd.accessors = flag.Bool("accessors", false, "C++: generate private members with public getters and setters instead of public members")
+	cmd.equality = flag.Bool("equality", false, "C++: generate operator== and a std::hash specialization, e.g. to use entities as std::unordered_map keys")
+
+	// for c & c++ generators
+	cmd.skip_deprecated = flag.Bool("skip-deprecated", false, "C/C++: leave out fields marked (deprecated) in the schema, removing them from the model; by default they're generated with a DEPRECATED comment")
+
+	// for go generator
+	cmd.package_name = flag.String("package", "", "Go: package name of the generated files, e.g. when generating to a different directory using -out; defaults to the package of the source file")
+	cmd.build_tag = flag.String("build-tag", "", "Go: build constraint expression written as a \"//go:build\" line to the generated files, e.g. objectbox")
+	cmd.fbs_out = flag.String("fbs-out", "", "Go: additionally write a FlatBuffers schema (.fbs) describing the model to the given path")
+
+	// for c generator
+	cmd.vector_alignment = flag.Int("vector-alignment", 0, "C: minimum alignment of vector elements (a power of two); defaults to the element size")
 }
 
 func (cmd *command) ParseFlags(remainingPosArgs *[]string, options *generator.Options) error {
-	var selectedLang string
+	var selected = make(map[string]bool)
 	for lang, val := range cmd.langs {
 		if *val {
-			if len(selectedLang) != 0 {
-				return fmt.Errorf("only one output language can be specified at the moment, you've selected %s and %s", selectedLang, lang)
-			}
-			selectedLang = lang
+			selected[lang] = true
 		}
 	}
 
-	if len(*cmd.optional) != 0 && selectedLang != "cpp" {
+	if selected["cpp"] && selected["cpp11"] {
+		return errors.New("only one of -cpp and -cpp11 can be specified, they generate the same files")
+	}
+
+	if len(*cmd.optional) != 0 && !selected["cpp"] {
 		return errors.New("argument -optional is only allowed in combination with -cpp")
 	}
 
-	switch selectedLang {
-	case "go":
-		options.CodeGenerator = &gogenerator.GoGenerator{}
-	case "c":
-		options.CodeGenerator = &cgenerator.CGenerator{
-			PlainC:      true,
-			LangVersion: -1,    // unspecified, take the default
-			Optional:    "ptr", // dummy value for checks to evaluate to true if "optional" annotation is used
+	if *cmd.accessors && !selected["cpp"] && !selected["cpp11"] {
+		return errors.New("argument -accessors is only allowed in combination with -cpp or -cpp11")
+	}
+
+	if *cmd.equality && !selected["cpp"] && !selected["cpp11"] {
+		return errors.New("argument -equality is only allowed in combination with -cpp or -cpp11")
+	}
+
+	if *cmd.skip_deprecated && !selected["c"] && !selected["cpp"] && !selected["cpp11"] {
+		return errors.New("argument -skip-deprecated is only allowed in combination with -c, -cpp or -cpp11")
+	}
+
+	if len(*cmd.fbs_out) != 0 && !selected["go"] {
+		return errors.New("argument -fbs-out is only allowed in combination with -go")
+	}
+
+	if len(*cmd.package_name) != 0 && !selected["go"] {
+		return errors.New("argument -package is only allowed in combination with -go")
+	}
+
+	if len(*cmd.build_tag) != 0 && !selected["go"] {
+		return errors.New("argument -build-tag is only allowed in combination with -go")
+	}
+
+	if *cmd.vector_alignment != 0 {
+		if !selected["c"] {
+			return errors.New("argument -vector-alignment is only allowed in combination with -c")
+		} else if *cmd.vector_alignment < 0 || *cmd.vector_alignment&(*cmd.vector_alignment-1) != 0 {
+			return fmt.Errorf("argument -vector-alignment must be a power of two, got %d", *cmd.vector_alignment)
+		}
+	}
+
+	// multiple languages share the same model, see generator.Options.AdditionalCodeGenerators
+	for _, lang := range []string{"c", "cpp", "cpp11", "go"} {
+		if !selected[lang] {
+			continue
 		}
-	case "cpp":
-		options.CodeGenerator = &cgenerator.CGenerator{
-			PlainC:            false,
-			LangVersion:       14,
-			Optional:          *cmd.optional,
-			EmptyStringAsNull: *cmd.empty_string_as_null,
-			NaNAsNull:         *cmd.nan_as_null,
+
+		var gen generator.CodeGenerator
+		switch lang {
+		case "go":
+			gen = &gogenerator.GoGenerator{FbsOut: *cmd.fbs_out, Package: *cmd.package_name, BuildTag: *cmd.build_tag}
+		case "c":
+			gen = &cgenerator.CGenerator{
+				PlainC:          true,
+				LangVersion:     -1,    // unspecified, take the default
+				Optional:        "ptr", // dummy value for checks to evaluate to true if "optional" annotation is used
+				VectorAlignment: *cmd.vector_alignment,
+				SkipDeprecated:  *cmd.skip_deprecated,
+			}
+		case "cpp":
+			gen = &cgenerator.CGenerator{
+				PlainC:            false,
+				LangVersion:       14,
+				Optional:          *cmd.optional,
+				EmptyStringAsNull: *cmd.empty_string_as_null,
+				NaNAsNull:         *cmd.nan_as_null,
+				Accessors:         *cmd.accessors,
+				Equality:          *cmd.equality,
+				SkipDeprecated:    *cmd.skip_deprecated,
+			}
+		case "cpp11":
+			gen = &cgenerator.CGenerator{
+				PlainC:            false,
+				LangVersion:       11,
+				Optional:          *cmd.optional,
+				EmptyStringAsNull: *cmd.empty_string_as_null,
+				NaNAsNull:         *cmd.nan_as_null,
+				Accessors:         *cmd.accessors,
+				Equality:          *cmd.equality,
+				SkipDeprecated:    *cmd.skip_deprecated,
+			}
 		}
-	case "cpp11":
-		options.CodeGenerator = &cgenerator.CGenerator{
-			PlainC:            false,
-			LangVersion:       11,
-			Optional:          *cmd.optional,
-			EmptyStringAsNull: *cmd.empty_string_as_null,
-			NaNAsNull:         *cmd.nan_as_null,
+
+		if options.CodeGenerator == nil {
+			options.CodeGenerator = gen
+		} else {
+			options.AdditionalCodeGenerators = append(options.AdditionalCodeGenerators, gen)
 		}
-	default:
+	}
+
+	if options.CodeGenerator == nil {
 		return errors.New("you must specify an output language")
 	}
 	return nil
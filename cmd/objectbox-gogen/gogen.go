@@ -40,7 +40,15 @@ func Main() {
 
 // implements generatorcmd.generatorCommand
 type command struct {
-	byValue bool
+	byValue      bool
+	clone        bool
+	equal        bool
+	observers    bool
+	stringer     bool
+	packageName  string
+	buildTag     string
+	relationLoad string
+	fbsOut       string
 }
 
 func (cmd command) ShowUsage() {
@@ -53,22 +61,49 @@ or
 	objectbox-gogen clean {path}
 		to remove the generated files instead of creating them - this removes *.obx.go and objectbox-model.go but keeps objectbox-model.json
 
+or
+
+	objectbox-gogen -orphans clean {path}
+		to remove only the generated files whose source file doesn't exist anymore
+
 path:
   * a source file path or a valid path pattern as accepted by the go tool (e.g. ./...)
   * if not given, the generator expects GOFILE environment variable to be set
 
 Available flags:
 `)
-	flag.PrintDefaults()
+	generatorcmd.PrintDefaults()
 }
 
 func (cmd *command) ConfigureFlags() {
 	flag.BoolVar(&cmd.byValue, "byValue", false, "getters should return a struct value (a copy) instead of a struct pointer")
+	flag.BoolVar(&cmd.clone, "clone", false, "generate a Clone() method (deep copy) for each entity")
+	flag.BoolVar(&cmd.equal, "equal", false, "generate an Equal() method comparing all stored properties for each entity")
+	flag.BoolVar(&cmd.stringer, "stringer", false, "generate a String() method printing the stored properties for each entity, e.g. for logging")
+	flag.BoolVar(&cmd.observers, "observers", false, "generate a typed Subscribe() method on boxes to observe data changes; requires objectbox-go with observer support")
+	flag.StringVar(&cmd.relationLoad, "relation-load", "eager", "default load policy of to-many relations, can be overridden by \"lazy\" and \"eager\" annotations; one of:\n"+
+		"  eager - related objects are read together with the source object, i.e. on Get()\n"+
+		"  lazy - related objects are only read when the generated Fetch*() method is called; cheaper reads if relations are rarely used")
+	flag.StringVar(&cmd.packageName, "package", "", "package name of the generated files, e.g. when generating to a different directory using -out; defaults to the package of the source file")
+	flag.StringVar(&cmd.buildTag, "build-tag", "", "build constraint expression written as a \"//go:build\" line to the generated files, e.g. objectbox")
+	flag.StringVar(&cmd.fbsOut, "fbs-out", "", "additionally write a FlatBuffers schema (.fbs) describing the model to the given path")
 }
 
 func (cmd *command) ParseFlags(remainingPosArgs *[]string, options *generator.Options) error {
+	if cmd.relationLoad != "eager" && cmd.relationLoad != "lazy" {
+		return fmt.Errorf("argument -relation-load must be either eager or lazy, got '%s'", cmd.relationLoad)
+	}
+
 	options.CodeGenerator = &gogenerator.GoGenerator{
-		ByValue: cmd.byValue,
+		ByValue:       cmd.byValue,
+		Clone:         cmd.clone,
+		Equal:         cmd.equal,
+		Observers:     cmd.observers,
+		Stringer:      cmd.stringer,
+		LazyRelations: cmd.relationLoad == "lazy",
+		FbsOut:        cmd.fbsOut,
+		Package:       cmd.packageName,
+		BuildTag:      cmd.buildTag,
 	}
 
 	if len(options.InPath) == 0 {
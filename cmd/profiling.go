@@ -0,0 +1,88 @@
+/*
+ * ObjectBox Generator - a build time tool for ObjectBox
+ * Copyright (C) 2018-2024 ObjectBox Ltd. All rights reserved.
+ * https://objectbox.io
+ *
+ * This file is part of ObjectBox Generator.
+ *
+ * ObjectBox Generator is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ * ObjectBox Generator is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with ObjectBox Generator.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package generatorcmd
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+)
+
+// hiddenFlags are accepted but not listed in the usage text, e.g. flags meant for debugging the generator itself
+var hiddenFlags = map[string]bool{
+	"cpuprofile": true,
+	"memprofile": true,
+}
+
+// PrintDefaults prints the default values of all flags, except for the hidden ones.
+// Use it instead of flag.PrintDefaults() when implementing generatorCommand.ShowUsage().
+func PrintDefaults() {
+	var visible = flag.NewFlagSet(flag.CommandLine.Name(), flag.ContinueOnError)
+	visible.SetOutput(flag.CommandLine.Output())
+	flag.VisitAll(func(f *flag.Flag) {
+		if !hiddenFlags[f.Name] {
+			visible.Var(f.Value, f.Name, f.Usage)
+			visible.Lookup(f.Name).DefValue = f.DefValue
+		}
+	})
+	visible.PrintDefaults()
+}
+
+// profiling holds the output paths for the (hidden) -cpuprofile and -memprofile flags
+type profiling struct {
+	cpuFile string
+	memFile string
+}
+
+// run executes the given function, writing a CPU profile of the execution and a heap profile afterwards, if requested
+func (p profiling) run(fn func() error) error {
+	if len(p.cpuFile) > 0 {
+		file, err := os.Create(p.cpuFile)
+		if err != nil {
+			return fmt.Errorf("can't create CPU profile file: %s", err)
+		}
+		defer file.Close()
+
+		if err = pprof.StartCPUProfile(file); err != nil {
+			return fmt.Errorf("can't start CPU profiling: %s", err)
+		}
+		defer pprof.StopCPUProfile()
+	}
+
+	var err = fn()
+
+	if len(p.memFile) > 0 {
+		file, errProfile := os.Create(p.memFile)
+		if errProfile != nil {
+			return fmt.Errorf("can't create memory profile file: %s", errProfile)
+		}
+		defer file.Close()
+
+		runtime.GC() // get up-to-date statistics
+		if errProfile = pprof.WriteHeapProfile(file); errProfile != nil && err == nil {
+			err = fmt.Errorf("can't write memory profile: %s", errProfile)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,152 @@
+/*
+ * ObjectBox Generator - a build time tool for ObjectBox
+ * Copyright (C) 2018-2024 ObjectBox Ltd. All rights reserved.
+ * https://objectbox.io
+ *
+ * This file is part of ObjectBox Generator.
+ *
+ * ObjectBox Generator is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ * ObjectBox Generator is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with ObjectBox Generator.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package generatorcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+
+	"github.com/objectbox/objectbox-generator/v4/internal/generator"
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/model"
+)
+
+// modelSummary is a machine-readable description of the model written by the -summary-json flag.
+// Contrary to the model JSON file, it only contains the current schema (no retired UIDs, no "last" IDs) and uses
+// readable type & flag names. UIDs are strings because they don't fit into a double-precision number, e.g. in JS.
+type modelSummary struct {
+	Entities []entitySummary `json:"entities"`
+}
+
+type entitySummary struct {
+	Name       string            `json:"name"`
+	Id         model.Id          `json:"id"`
+	Uid        string            `json:"uid"`
+	Flags      []string          `json:"flags"`
+	Properties []propertySummary `json:"properties"`
+	Relations  []relationSummary `json:"relations"`
+}
+
+type propertySummary struct {
+	Name           string   `json:"name"`
+	Id             model.Id `json:"id"`
+	Uid            string   `json:"uid"`
+	Type           string   `json:"type"`
+	Flags          []string `json:"flags"`
+	RelationTarget string   `json:"relationTarget,omitempty"`
+}
+
+type relationSummary struct {
+	Name   string   `json:"name"`
+	Id     model.Id `json:"id"`
+	Uid    string   `json:"uid"`
+	Target string   `json:"target"`
+}
+
+// writeModelSummary reads the model JSON file written by generator.Process() and writes its summary to the given path
+func writeModelSummary(options generator.Options, path string) error {
+	var modelFile = options.ModelInfoFile
+	if len(modelFile) == 0 {
+		modelFile = generator.ModelInfoFile(filepath.Dir(options.InPath))
+	}
+
+	modelInfo, err := model.LoadModelFromJSONFile(modelFile)
+	if err != nil {
+		return fmt.Errorf("can't read the model for the summary: %s", err)
+	}
+	defer modelInfo.Close()
+
+	if err = modelInfo.Validate(); err != nil {
+		return fmt.Errorf("can't read the model for the summary: %s", err)
+	}
+
+	data, err := json.MarshalIndent(summarizeModel(modelInfo), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("can't write model summary %s: %s", path, err)
+	}
+	return nil
+}
+
+func summarizeModel(modelInfo *model.ModelInfo) modelSummary {
+	var summary = modelSummary{Entities: []entitySummary{}}
+	for _, entity := range modelInfo.Entities {
+		id, uid, _ := entity.Id.Get()
+		var entitySum = entitySummary{
+			Name:       entity.Name,
+			Id:         id,
+			Uid:        strconv.FormatUint(uid, 10),
+			Flags:      []string{},
+			Properties: []propertySummary{},
+			Relations:  []relationSummary{},
+		}
+		for flag := model.EntityFlags(1); flag != 0 && flag <= entity.Flags; flag <<= 1 {
+			if entity.Flags&flag != 0 {
+				entitySum.Flags = append(entitySum.Flags, model.EntityFlagNames[flag])
+			}
+		}
+
+		for _, property := range entity.Properties {
+			id, uid, _ := property.Id.Get()
+			var propertySum = propertySummary{
+				Name:           property.Name,
+				Id:             id,
+				Uid:            strconv.FormatUint(uid, 10),
+				Type:           model.PropertyTypeNames[property.Type],
+				Flags:          propertyFlagNames(property.Flags),
+				RelationTarget: property.RelationTarget,
+			}
+			entitySum.Properties = append(entitySum.Properties, propertySum)
+		}
+
+		for _, relation := range entity.Relations {
+			id, uid, _ := relation.Id.Get()
+			var relationSum = relationSummary{
+				Name: relation.Name,
+				Id:   id,
+				Uid:  strconv.FormatUint(uid, 10),
+			}
+			if relation.Target != nil {
+				relationSum.Target = relation.Target.Name
+			}
+			entitySum.Relations = append(entitySum.Relations, relationSum)
+		}
+
+		summary.Entities = append(summary.Entities, entitySum)
+	}
+	return summary
+}
+
+// propertyFlagNames returns the names of all flags set, in the order of their values
+func propertyFlagNames(flags model.PropertyFlags) []string {
+	var names = []string{}
+	for flag := model.PropertyFlags(1); flag != 0 && flag <= flags; flag <<= 1 {
+		if flags&flag != 0 {
+			names = append(names, model.PropertyFlagNames[flag])
+		}
+	}
+	return names
+}
@@ -93,8 +93,10 @@ func ParseAnnotations(str string, annotations *map[string]*Annotation, supported
 					supportedDetails = map[string]bool{"sharedglobalids": true}
 				} else if s.name == "id" {
 					supportedDetails = map[string]bool{"assignable": true}
+				} else if s.name == "converter" {
+					supportedDetails = map[string]bool{"fmt": true}
 				} else {
-					return fmt.Errorf("invalid annotation format: details only supported for `relation` & `sync` annotations, found `%s`", s.name)
+					return fmt.Errorf("invalid annotation format: details only supported for `relation`, `sync`, `id` & `converter` annotations, found `%s`", s.name)
 				}
 				if err := ParseAnnotations(detailsStr, &s.value.Details, supportedDetails); err != nil {
 					return err
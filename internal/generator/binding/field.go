@@ -73,11 +73,20 @@ func (field *Field) ProcessAnnotations(a map[string]*Annotation) error {
 		return nil
 	}
 
+	// `assignable` may be given either as a standalone annotation or as an `id(assignable)` detail, in any order
+	if a["assignable"] != nil {
+		if a["id"] == nil {
+			return errors.New("assignable annotation is only valid on an ID property, use it together with `id`")
+		} else if len(a["assignable"].Value) != 0 {
+			return errors.New("assignable annotation value must be empty")
+		}
+	}
+
 	if a["id"] != nil {
 		field.ModelProperty.AddFlag(model.PropertyFlagId)
 		if hasDetail, err := HasBooleanDetail(a, "id", "assignable"); err != nil {
 			return err
-		} else if hasDetail {
+		} else if hasDetail || a["assignable"] != nil {
 			field.ModelProperty.AddFlag(model.PropertyFlagIdSelfAssignable)
 		}
 	}
@@ -94,8 +103,9 @@ func (field *Field) ProcessAnnotations(a map[string]*Annotation) error {
 			return errors.New("date and date-nano annotations cannot be used at the same time")
 		}
 
+		// both signed and unsigned 64-bit integers are accepted, the latter only carry an additional "unsigned" flag
 		if field.ModelProperty.Type != model.PropertyTypeLong {
-			return fmt.Errorf("invalid underlying type '%v' for date/date-nano field; expecting long", model.PropertyTypeNames[field.ModelProperty.Type])
+			return fmt.Errorf("invalid underlying type '%v' for date/date-nano field; expecting long (signed or unsigned)", model.PropertyTypeNames[field.ModelProperty.Type])
 		}
 
 		if a["date"] != nil {
@@ -113,6 +123,16 @@ func (field *Field) ProcessAnnotations(a map[string]*Annotation) error {
 	}
 
 	if a["unique"] != nil {
+		// the value selects what happens on a conflicting put: fail (the default) or replace the conflicting object.
+		// Note: indexes in the model always cover a single property so a value can't be e.g. a group name.
+		switch a["unique"].Value {
+		case "":
+		case "replace":
+			field.ModelProperty.AddFlag(model.PropertyFlagUniqueOnConflictReplace)
+		default:
+			return fmt.Errorf("unknown unique conflict strategy '%s', expecting replace or no value; note: composite "+
+				"(multi-property) unique constraints are not supported, an index always covers a single property", a["unique"].Value)
+		}
 		field.ModelProperty.AddFlag(model.PropertyFlagUnique)
 
 		// add a default index type, unless specified otherwise
@@ -157,6 +177,9 @@ func (field *Field) ProcessAnnotations(a map[string]*Annotation) error {
 			// in case the user doesn't provide `objectbox:"uid"` value, it's considered in-process of setting up UID
 			// this flag is handled by the merge mechanism and prints the UID of the already existing property
 			field.ModelProperty.UidRequest = true
+		} else if a["uid"].Value == "reset" {
+			// a new UID is generated by the merge mechanism, resetting the property data, see getModelProperty()
+			field.ModelProperty.UidReset = true
 		} else if uid, err := strconv.ParseUint(a["uid"].Value, 10, 64); err != nil {
 			return fmt.Errorf("can't parse uid - %s", err)
 		} else if id, err := field.ModelProperty.Id.GetIdAllowZero(); err != nil {
@@ -198,6 +221,8 @@ func (field *Field) ProcessAnnotations(a map[string]*Annotation) error {
 		dimensions, err := strconv.ParseUint(a["hnsw-dimensions"].Value, 10, 64)
 		if err != nil {
 			return fmt.Errorf("Annotation 'hnsw-dimensions' value type mismatch: %s", err)
+		} else if dimensions == 0 {
+			return fmt.Errorf("Annotation 'hnsw-dimensions' value out of range: must be at least 1")
 		}
 		field.ModelProperty.HnswParams.Dimensions = &dimensions
 	}
@@ -206,12 +231,15 @@ func (field *Field) ProcessAnnotations(a map[string]*Annotation) error {
 		if err := field.ModelProperty.CheckHnswParams(); err != nil {
 			return err
 		}
-		distanceType := a["hnsw-distance-type"].Value
-		switch distanceType {
-		case "Unknown", "Euclidean", "Cosine", "DotProduct", "DotProductNonNormalized":
-			break
-		default:
-			return fmt.Errorf("Annotation 'hnsw-distance-type' value type mismatch: must be one of 'Unknown', 'Euclidean', 'Cosine', 'DotProduct', 'DotPropductNonNormalized'")
+		// accept the names case-insensitively (e.g. "cosine") but always store the canonical one
+		var distanceType string
+		for _, name := range []string{model.HnswDistanceType_Unknown, model.HnswDistanceType_Euclidean, model.HnswDistanceType_Cosine, model.HnswDistanceType_DotProduct, model.HnswDistanceType_DotProductNonNormalized} {
+			if strings.EqualFold(name, a["hnsw-distance-type"].Value) {
+				distanceType = name
+			}
+		}
+		if len(distanceType) == 0 {
+			return fmt.Errorf("Annotation 'hnsw-distance-type' value type mismatch: must be one of 'Unknown', 'Euclidean', 'Cosine', 'DotProduct', 'DotProductNonNormalized'")
 		}
 		field.ModelProperty.HnswParams.DistanceType = distanceType
 	}
@@ -223,6 +251,8 @@ func (field *Field) ProcessAnnotations(a map[string]*Annotation) error {
 		value, err := strconv.ParseUint(a["hnsw-neighbors-per-node"].Value, 10, 32)
 		if err != nil {
 			return fmt.Errorf("Annotation 'hnsw-neighbors-per-node' value type mismatch: %s", err)
+		} else if value == 0 {
+			return fmt.Errorf("Annotation 'hnsw-neighbors-per-node' value out of range: must be at least 1")
 		}
 		var neighborsPerNode uint32 = uint32(value)
 		field.ModelProperty.HnswParams.NeighborsPerNode = &neighborsPerNode
@@ -233,7 +263,9 @@ func (field *Field) ProcessAnnotations(a map[string]*Annotation) error {
 		}
 		value, err := strconv.ParseUint(a["hnsw-indexing-search-count"].Value, 10, 32)
 		if err != nil {
-			return fmt.Errorf("Annotation 'hnsw-neighbors-per-node' value type mismatch: %s", err)
+			return fmt.Errorf("Annotation 'hnsw-indexing-search-count' value type mismatch: %s", err)
+		} else if value == 0 {
+			return fmt.Errorf("Annotation 'hnsw-indexing-search-count' value out of range: must be at least 1")
 		}
 		var indexingSearchCount uint32 = uint32(value)
 		field.ModelProperty.HnswParams.IndexingSearchCount = &indexingSearchCount
@@ -245,6 +277,8 @@ func (field *Field) ProcessAnnotations(a map[string]*Annotation) error {
 		value, err := strconv.ParseFloat(a["hnsw-reparation-backlink-probability"].Value, 32)
 		if err != nil {
 			return fmt.Errorf("Annotation 'hnsw-reparation-backlink-probability' value type mismatch: %s", err)
+		} else if value < 0 || value > 1 {
+			return fmt.Errorf("Annotation 'hnsw-reparation-backlink-probability' value out of range: must be between 0.0 and 1.0")
 		}
 		var reparationBacklinkProbability float32 = float32(value)
 		field.ModelProperty.HnswParams.ReparationBacklinkProbability = &reparationBacklinkProbability
@@ -255,10 +289,9 @@ func (field *Field) ProcessAnnotations(a map[string]*Annotation) error {
 		}
 		vectorCacheHintSizeKb, err := strconv.ParseUint(a["hnsw-vector-cache-hint-size-kb"].Value, 10, 64)
 		if err != nil {
-			return fmt.Errorf("Annotation 'hnsw-dimensions' value type mismatch: %s", err)
+			return fmt.Errorf("Annotation 'hnsw-vector-cache-hint-size-kb' value type mismatch: %s", err)
 		}
 		field.ModelProperty.HnswParams.VectorCacheHintSizeKb = &vectorCacheHintSizeKb
-
 	}
 	if a["hnsw-flags"] != nil {
 		if err := field.ModelProperty.CheckHnswParams(); err != nil {
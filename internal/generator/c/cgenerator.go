@@ -31,6 +31,7 @@ import (
 	"github.com/objectbox/objectbox-generator/v4/internal/generator"
 	"github.com/objectbox/objectbox-generator/v4/internal/generator/c/templates"
 	"github.com/objectbox/objectbox-generator/v4/internal/generator/flatbuffersc"
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/flatbuffersc/reflection"
 	"github.com/objectbox/objectbox-generator/v4/internal/generator/model"
 )
 
@@ -40,6 +41,10 @@ type CGenerator struct {
 	Optional          string // std::optional, std::unique_ptr, std::shared_ptr
 	EmptyStringAsNull bool
 	NaNAsNull         bool
+	VectorAlignment   int  // C: minimum alignment of vector elements, 0 to use the natural alignment (element size)
+	Accessors         bool // C++: private members with public getters and setters instead of public members
+	Equality          bool // C++: generate operator== and a std::hash specialization for each entity
+	SkipDeprecated    bool // leave out fields marked (deprecated) in the schema instead of generating them with a comment
 }
 
 // BindingFiles returns the names of the generated C or C++ language binding files for the given entity file.
@@ -52,7 +57,7 @@ func (gen *CGenerator) BindingFiles(forFile string, options generator.Options) [
 	var base = forFile[0 : len(forFile)-len(extension)]
 
 	if gen.PlainC {
-		return []string{base + ".obx.h"}
+		return []string{base + options.BindingSuffix() + ".h"}
 	}
 	var headerBase = base
 	if len(options.OutHeadersPath) > 0 {
@@ -60,7 +65,7 @@ func (gen *CGenerator) BindingFiles(forFile string, options generator.Options) [
 		headerBase = headerBase[0 : len(headerBase)-len(extension)]
 	}
 
-	return []string{headerBase + ".obx.hpp", base + ".obx.cpp"}
+	return []string{headerBase + options.BindingSuffix() + ".hpp", base + options.BindingSuffix() + ".cpp"}
 }
 
 // ModelFile returns the generated model C header file for the given JSON info file path
@@ -75,32 +80,64 @@ func (gen *CGenerator) ModelFile(forFile string, options generator.Options) stri
 	return forFile[0:len(forFile)-len(extension)] + ".h"
 }
 
-func (CGenerator) IsGeneratedFile(file string) bool {
+func (CGenerator) IsGeneratedFile(file string, options generator.Options) bool {
 	var name = filepath.Base(file)
+	var suffix = options.BindingSuffix()
 	return name == "objectbox-model.h" ||
-		strings.HasSuffix(name, ".obx.h") ||
-		strings.HasSuffix(name, ".obx.hpp") ||
-		strings.HasSuffix(name, ".obx.cpp")
+		strings.HasSuffix(name, suffix+".h") ||
+		strings.HasSuffix(name, suffix+".hpp") ||
+		strings.HasSuffix(name, suffix+".cpp")
 }
 
 func (CGenerator) IsSourceFile(file string) bool {
 	return strings.HasSuffix(file, ".fbs")
 }
 
+// SupportedTypes lists FlatBuffers schema types, including vectors, that can be used for properties.
+func (CGenerator) SupportedTypes() []generator.TypeMapping {
+	var result []generator.TypeMapping
+	var fbsTypeName = func(fbsType reflection.BaseType) string {
+		return strings.ToLower(reflection.EnumNamesBaseType[fbsType])
+	}
+	for fbsType := reflection.BaseTypeNone; fbsType <= reflection.BaseTypeArray; fbsType++ {
+		if obxType := fbsTypeToObxType[fbsType]; obxType != 0 {
+			result = append(result, generator.TypeMapping{SourceType: fbsTypeName(fbsType), PropertyType: obxType, Flags: fbsTypeToObxFlag[fbsType]})
+		}
+	}
+	for fbsType := reflection.BaseTypeNone; fbsType <= reflection.BaseTypeArray; fbsType++ {
+		if obxType := fbsVectorTypeToObxType[fbsType]; obxType != 0 {
+			result = append(result, generator.TypeMapping{SourceType: "[" + fbsTypeName(fbsType) + "]", PropertyType: obxType})
+		}
+	}
+	return result
+}
+
 func (gen *CGenerator) ParseSource(sourceFile string) (*model.ModelInfo, error) {
 	schemaReflection, err := flatbuffersc.ParseSchemaFile(sourceFile)
 	if err != nil {
 		return nil, err // already includes file name so no more context should be necessary
 	}
 
-	reader := fbSchemaReader{model: &model.ModelInfo{}, optional: gen.Optional}
+	reader := fbSchemaReader{model: &model.ModelInfo{}, optional: gen.Optional, skipDeprecated: gen.SkipDeprecated}
 	if err = reader.read(schemaReflection); err != nil {
 		return nil, fmt.Errorf("error generating model from schema %s: %s", sourceFile, err)
 	}
 
+	if gen.Accessors && !gen.PlainC {
+		if err = checkAccessorNames(reader.model); err != nil {
+			return nil, fmt.Errorf("error generating model from schema %s: %s", sourceFile, err)
+		}
+	}
+
 	return reader.model, nil
 }
 
+// ParseSourceConcurrently implements generator.ConcurrentSourceParser, parsing doesn't keep any state in the generator
+func (gen *CGenerator) ParseSourceConcurrently(sourceFile string) (*model.ModelInfo, func(), error) {
+	parsed, err := gen.ParseSource(sourceFile)
+	return parsed, nil, err
+}
+
 func (gen *CGenerator) WriteBindingFiles(sourceFile string, options generator.Options, mergedModel *model.ModelInfo) error {
 	var err, err2 error
 
@@ -108,7 +145,7 @@ func (gen *CGenerator) WriteBindingFiles(sourceFile string, options generator.Op
 
 	for _, bindingFile := range bindingFiles {
 		var bindingSource []byte
-		if bindingSource, err = gen.generateBindingFile(bindingFile, bindingFiles[0], mergedModel); err != nil {
+		if bindingSource, err = gen.generateBindingFile(options, bindingFile, bindingFiles[0], mergedModel); err != nil {
 			return fmt.Errorf("can't generate binding file %s: %s", sourceFile, err)
 		}
 
@@ -119,7 +156,7 @@ func (gen *CGenerator) WriteBindingFiles(sourceFile string, options generator.Op
 			bindingSource = formattedSource
 		}
 
-		if err = generator.WriteFile(bindingFile, bindingSource, sourceFile); err != nil {
+		if err = options.WriteOutput(bindingFile, bindingSource, sourceFile); err != nil {
 			return fmt.Errorf("can't write binding file %s: %s", sourceFile, err)
 		} else if err2 != nil {
 			// now when the binding has been written (for debugging purposes), we can return the error
@@ -130,7 +167,7 @@ func (gen *CGenerator) WriteBindingFiles(sourceFile string, options generator.Op
 	return nil
 }
 
-func (gen *CGenerator) generateBindingFile(bindingFile, headerFile string, m *model.ModelInfo) (data []byte, err error) {
+func (gen *CGenerator) generateBindingFile(options generator.Options, bindingFile, headerFile string, m *model.ModelInfo) (data []byte, err error) {
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
 
@@ -139,6 +176,7 @@ func (gen *CGenerator) generateBindingFile(bindingFile, headerFile string, m *mo
 	fileIdentifier = replaceSpecialChars.Replace(fileIdentifier)
 
 	var tplArguments = struct {
+		Banner            string
 		Model             *model.ModelInfo
 		GeneratorVersion  int
 		FileIdentifier    string
@@ -147,7 +185,10 @@ func (gen *CGenerator) generateBindingFile(bindingFile, headerFile string, m *mo
 		LangVersion       int
 		EmptyStringAsNull bool
 		NaNAsNull         bool
-	}{m, generator.VersionId, fileIdentifier, filepath.Base(headerFile), gen.Optional, gen.LangVersion, gen.EmptyStringAsNull, gen.NaNAsNull}
+		VectorAlignment   int
+		Accessors         bool
+		Equality          bool
+	}{options.Banner(), m, generator.VersionId, fileIdentifier, filepath.Base(headerFile), gen.Optional, gen.LangVersion, gen.EmptyStringAsNull, gen.NaNAsNull, gen.VectorAlignment, gen.Accessors, gen.Equality}
 
 	var tpl *template.Template
 
@@ -176,7 +217,7 @@ func (gen *CGenerator) WriteModelBindingFile(options generator.Options, mergedMo
 	var modelFile = gen.ModelFile(options.ModelInfoFile, options)
 	var modelSource []byte
 
-	if modelSource, err = generateModelFile(mergedModel); err != nil {
+	if modelSource, err = generateModelFile(options, mergedModel); err != nil {
 		return fmt.Errorf("can't generate model file %s: %s", modelFile, err)
 	}
 
@@ -187,7 +228,7 @@ func (gen *CGenerator) WriteModelBindingFile(options generator.Options, mergedMo
 		modelSource = formattedSource
 	}
 
-	if err = generator.WriteFile(modelFile, modelSource, options.ModelInfoFile); err != nil {
+	if err = options.WriteOutput(modelFile, modelSource, options.ModelInfoFile); err != nil {
 		return fmt.Errorf("can't write model file %s: %s", modelFile, err)
 	} else if err2 != nil {
 		// now when the model has been written (for debugging purposes), we can return the error
@@ -197,14 +238,15 @@ func (gen *CGenerator) WriteModelBindingFile(options generator.Options, mergedMo
 	return nil
 }
 
-func generateModelFile(m *model.ModelInfo) (data []byte, err error) {
+func generateModelFile(options generator.Options, m *model.ModelInfo) (data []byte, err error) {
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
 
 	var tplArguments = struct {
+		Banner           string
 		Model            *model.ModelInfo
 		GeneratorVersion int
-	}{m, generator.VersionId}
+	}{options.Banner(), m, generator.VersionId}
 
 	if err = templates.ModelTemplate.Execute(writer, tplArguments); err != nil {
 		return nil, fmt.Errorf("template execution failed: %s", err)
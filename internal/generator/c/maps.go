@@ -48,6 +48,21 @@ var fbsTypeToObxType = map[reflection.BaseType]model.PropertyType{
 	reflection.BaseTypeArray:  0, // not supported
 }
 
+// fbsVectorTypeToObxType maps supported vector element types to the property type of the whole vector
+var fbsVectorTypeToObxType = map[reflection.BaseType]model.PropertyType{
+	reflection.BaseTypeByte:   model.PropertyTypeByteVector,
+	reflection.BaseTypeUByte:  model.PropertyTypeByteVector,
+	reflection.BaseTypeShort:  model.PropertyTypeShortVector,
+	reflection.BaseTypeUShort: model.PropertyTypeShortVector,
+	reflection.BaseTypeInt:    model.PropertyTypeIntVector,
+	reflection.BaseTypeUInt:   model.PropertyTypeIntVector,
+	reflection.BaseTypeLong:   model.PropertyTypeLongVector,
+	reflection.BaseTypeULong:  model.PropertyTypeLongVector,
+	reflection.BaseTypeFloat:  model.PropertyTypeFloatVector,
+	reflection.BaseTypeDouble: model.PropertyTypeDoubleVector,
+	reflection.BaseTypeString: model.PropertyTypeStringVector,
+}
+
 var fbsTypeToObxFlag = map[reflection.BaseType]model.PropertyFlags{
 	reflection.BaseTypeUByte:  model.PropertyFlagUnsigned,
 	reflection.BaseTypeUShort: model.PropertyFlagUnsigned,
@@ -0,0 +1,64 @@
+/*
+ * ObjectBox Generator - a build time tool for ObjectBox
+ * Copyright (C) 2024 ObjectBox Ltd. All rights reserved.
+ * https://objectbox.io
+ *
+ * This file is part of ObjectBox Generator.
+ *
+ * ObjectBox Generator is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ * ObjectBox Generator is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with ObjectBox Generator.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cgenerator
+
+import (
+	"testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/flatbuffersc/reflection"
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/model"
+	"github.com/objectbox/objectbox-generator/v4/test/assert"
+)
+
+func TestScalarTypeMaps(t *testing.T) {
+	var tests = []struct {
+		fbsType  reflection.BaseType
+		obxType  model.PropertyType
+		obxFlags model.PropertyFlags
+		cppType  string
+		size     uint8
+		flatcc   string
+	}{
+		{reflection.BaseTypeBool, model.PropertyTypeBool, 0, "bool", flatbuffers.SizeBool, "flatbuffers_bool"},
+		{reflection.BaseTypeByte, model.PropertyTypeByte, 0, "int8_t", 1, "flatbuffers_int8"},
+		{reflection.BaseTypeUByte, model.PropertyTypeByte, model.PropertyFlagUnsigned, "uint8_t", 1, "flatbuffers_uint8"},
+		{reflection.BaseTypeShort, model.PropertyTypeShort, 0, "int16_t", 2, "flatbuffers_int16"},
+		{reflection.BaseTypeUShort, model.PropertyTypeShort, model.PropertyFlagUnsigned, "uint16_t", 2, "flatbuffers_uint16"},
+		{reflection.BaseTypeInt, model.PropertyTypeInt, 0, "int32_t", 4, "flatbuffers_int32"},
+		{reflection.BaseTypeUInt, model.PropertyTypeInt, model.PropertyFlagUnsigned, "uint32_t", 4, "flatbuffers_uint32"},
+		{reflection.BaseTypeLong, model.PropertyTypeLong, 0, "int64_t", 8, "flatbuffers_int64"},
+		{reflection.BaseTypeULong, model.PropertyTypeLong, model.PropertyFlagUnsigned, "uint64_t", 8, "flatbuffers_uint64"},
+		{reflection.BaseTypeFloat, model.PropertyTypeFloat, 0, "float", 4, "flatbuffers_float"},
+		{reflection.BaseTypeDouble, model.PropertyTypeDouble, 0, "double", 8, "flatbuffers_double"},
+	}
+
+	for _, test := range tests {
+		t.Run(reflection.EnumNamesBaseType[test.fbsType], func(t *testing.T) {
+			assert.Eq(t, test.obxType, fbsTypeToObxType[test.fbsType])
+			assert.Eq(t, test.obxFlags, fbsTypeToObxFlag[test.fbsType])
+			assert.Eq(t, test.cppType, fbsTypeToCppType[test.fbsType])
+			assert.Eq(t, test.size, fbsTypeSize[test.fbsType])
+			assert.Eq(t, test.flatcc, fbsTypeToFlatccFnPrefix[test.fbsType])
+		})
+	}
+}
@@ -142,6 +142,7 @@ func (mo *fbsObject) PreDeclareCppRelTargets() (string, error) {
 type fbsField struct {
 	*binding.Field
 	fbsField *reflection.Field
+	enumName string // fully qualified name of the FlatBuffers enum if the field is enum-typed
 }
 
 // Merge implements model.PropertyMeta interface
@@ -155,6 +156,21 @@ func (mp *fbsField) CppName() string {
 	return cppName(mp.Name)
 }
 
+// EnumName returns the FlatBuffers schema enum name (including namespace) the property values belong to, if any
+func (mp *fbsField) EnumName() string {
+	return mp.enumName
+}
+
+// CppGetterName returns the name of the getter generated in the C++ accessors mode
+func (mp *fbsField) CppGetterName() string {
+	return "get" + strings.ToUpper(mp.Name[:1]) + mp.Name[1:]
+}
+
+// CppSetterName returns the name of the setter generated in the C++ accessors mode
+func (mp *fbsField) CppSetterName() string {
+	return "set" + strings.ToUpper(mp.Name[:1]) + mp.Name[1:]
+}
+
 // CppNameRelationTarget returns C++ target class name with reserved keywords suffixed by an underscore
 func (mp *fbsField) CppNameRelationTarget() string {
 	return cppNamespacePrefix(mp.relTargetNamespace()) + cppName(mp.ModelProperty.RelationTarget)
@@ -207,23 +223,28 @@ func (mp *fbsField) FbIsVector() bool {
 	switch mp.ModelProperty.Type {
 	case model.PropertyTypeString:
 		return true
-	case model.PropertyTypeByteVector:
-		return true
-	case model.PropertyTypeFloatVector:
-		return true
 	case model.PropertyTypeStringVector:
 		return true
 	}
+	return mp.isScalarVector()
+}
+
+// isScalarVector returns true if the property is a vector of numbers (including bytes)
+func (mp *fbsField) isScalarVector() bool {
+	switch mp.ModelProperty.Type {
+	case model.PropertyTypeByteVector, model.PropertyTypeShortVector, model.PropertyTypeIntVector,
+		model.PropertyTypeLongVector, model.PropertyTypeFloatVector, model.PropertyTypeDoubleVector:
+		return true
+	}
 	return false
 }
 
 // CElementType returns C vector element type name
 func (mp *fbsField) CElementType() string {
-	switch mp.ModelProperty.Type {
-	case model.PropertyTypeByteVector:
-		return fbsTypeToCppType[mp.fbsField.Type(nil).Element()]
-	case model.PropertyTypeFloatVector:
+	if mp.isScalarVector() {
 		return fbsTypeToCppType[mp.fbsField.Type(nil).Element()]
+	}
+	switch mp.ModelProperty.Type {
 	case model.PropertyTypeString:
 		return "char"
 	case model.PropertyTypeStringVector:
@@ -232,6 +253,16 @@ func (mp *fbsField) CElementType() string {
 	return ""
 }
 
+// FbVectorAlignment returns the alignment of the vector elements as used when creating the vector in flatcc.
+// The natural alignment (element size) is used unless a larger minimum is requested (e.g. by a platform requirement).
+func (mp *fbsField) FbVectorAlignment(minimum int) int {
+	var alignment = int(fbsTypeSize[mp.fbsField.Type(nil).Element()])
+	if minimum > alignment {
+		return minimum
+	}
+	return alignment
+}
+
 // FlatccFnPrefix returns the field's type as used in Flatcc.
 func (mp *fbsField) FlatccFnPrefix() string {
 	return fbsTypeToFlatccFnPrefix[mp.fbsField.Type(nil).BaseType()]
@@ -245,13 +276,12 @@ func (mp *fbsField) FbTypeSize() uint8 {
 // FbOffsetFactory returns an offset factory used to build flatbuffers if this property is a complex type.
 // See also FbOffsetType().
 func (mp *fbsField) FbOffsetFactory() string {
+	if mp.isScalarVector() {
+		return "CreateVector"
+	}
 	switch mp.ModelProperty.Type {
 	case model.PropertyTypeString:
 		return "CreateString"
-	case model.PropertyTypeByteVector:
-		return "CreateVector"
-	case model.PropertyTypeFloatVector:
-		return "CreateVector"
 	case model.PropertyTypeStringVector:
 		return "CreateVectorOfStrings"
 	}
@@ -261,13 +291,12 @@ func (mp *fbsField) FbOffsetFactory() string {
 // FbOffsetType returns a type used to read flatbuffers if this property is a complex type.
 // See also FbOffsetFactory().
 func (mp *fbsField) FbOffsetType() string {
+	if mp.isScalarVector() {
+		return "flatbuffers::Vector<" + fbsTypeToCppType[mp.fbsField.Type(nil).Element()] + ">"
+	}
 	switch mp.ModelProperty.Type {
 	case model.PropertyTypeString:
 		return "flatbuffers::Vector<char>"
-	case model.PropertyTypeByteVector:
-		return "flatbuffers::Vector<" + fbsTypeToCppType[mp.fbsField.Type(nil).Element()] + ">"
-	case model.PropertyTypeFloatVector:
-		return "flatbuffers::Vector<" + fbsTypeToCppType[mp.fbsField.Type(nil).Element()] + ">"
 	case model.PropertyTypeStringVector:
 		return "" // NOTE custom handling in the template
 	}
@@ -321,3 +350,25 @@ func (mr *standaloneRel) Merge(rel *model.StandaloneRelation) model.StandaloneRe
 func (mr *standaloneRel) CppName() string {
 	return cppName(mr.ModelRelation.Name)
 }
+
+// checkAccessorNames verifies that getters and setters generated in the C++ accessors mode don't collide with other
+// members of the entity struct.
+func checkAccessorNames(m *model.ModelInfo) error {
+	for _, entity := range m.Entities {
+		var members = map[string]string{"_OBX_MetaInfo": "the meta-info struct"}
+		for _, prop := range entity.Properties {
+			members[prop.Meta.(*fbsField).CppName()] = "property " + prop.Name
+		}
+
+		for _, prop := range entity.Properties {
+			var field = prop.Meta.(*fbsField)
+			for _, accessor := range []string{field.CppGetterName(), field.CppSetterName()} {
+				if other, exists := members[accessor]; exists {
+					return fmt.Errorf("accessor %s() of property %s.%s collides with %s", accessor, entity.Name, prop.Name, other)
+				}
+				members[accessor] = "an accessor of property " + prop.Name
+			}
+		}
+	}
+	return nil
+}
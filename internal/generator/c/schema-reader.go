@@ -40,6 +40,7 @@ var supportedEntityAnnotations = map[string]bool{
 }
 
 var supportedPropertyAnnotations = map[string]bool{
+	"assignable":                           true,
 	"date":                                 true,
 	"date-nano":                            true,
 	"id":                                   true,
@@ -65,13 +66,20 @@ type fbSchemaReader struct {
 	// model produced by reading the schema
 	model *model.ModelInfo
 
+	// schema being read, used to resolve enums referenced by fields
+	schema *reflection.Schema
+
 	// see CGenerator.Optional
 	optional string
+
+	// see CGenerator.SkipDeprecated
+	skipDeprecated bool
 }
 
 // const annotationPrefix = "objectbox:"
 
 func (r *fbSchemaReader) read(schema *reflection.Schema) error {
+	r.schema = schema
 	for i := 0; i < schema.ObjectsLength(); i++ {
 		var object reflection.Object
 		if !schema.Objects(&object, i) {
@@ -140,7 +148,7 @@ func (r *fbSchemaReader) readObject(object *reflection.Object) error {
 
 func (r *fbSchemaReader) readObjectField(entity *model.Entity, field *reflection.Field) error {
 	var property = model.CreateProperty(entity, 0, 0)
-	var metaProperty = &fbsField{binding.CreateField(property), field}
+	var metaProperty = &fbsField{Field: binding.CreateField(property), fbsField: field}
 	property.Meta = metaProperty
 	metaProperty.SetName(string(field.Name()))
 
@@ -163,26 +171,33 @@ func (r *fbSchemaReader) readObjectField(entity *model.Entity, field *reflection
 		return nil
 	}
 
+	if field.Deprecated() {
+		if r.skipDeprecated {
+			return nil
+		}
+		property.Comments = append(property.Comments, "DEPRECATED")
+	}
+
 	if fbsType := field.Type(nil); fbsType == nil {
 		return errors.New("can't access Type() from the source schema")
 	} else {
 		var fbsBaseType = fbsType.BaseType()
 		if fbsBaseType == reflection.BaseTypeVector {
 			var fbsElBaseType = fbsType.Element()
-			switch fbsElBaseType {
-			case reflection.BaseTypeString:
-				property.Type = model.PropertyTypeStringVector
-			case reflection.BaseTypeByte:
-				fallthrough
-			case reflection.BaseTypeUByte:
-				property.Type = model.PropertyTypeByteVector
-			case reflection.BaseTypeFloat:
-				property.Type = model.PropertyTypeFloatVector
-			default:
+			property.Type = fbsVectorTypeToObxType[fbsElBaseType]
+			if property.Type == 0 {
 				return fmt.Errorf("unsupported vector element type: %s", reflection.EnumNamesBaseType[fbsElBaseType])
 			}
 		} else {
+			// enum fields are stored using the underlying integer type, which is already the field's base type
 			property.Type = fbsTypeToObxType[fbsBaseType]
+			if fbsType.Index() >= 0 && property.Type != 0 {
+				var enum reflection.Enum
+				if !r.schema.Enums(&enum, int(fbsType.Index())) {
+					return fmt.Errorf("can't access enum %d", fbsType.Index())
+				}
+				metaProperty.enumName = string(enum.Name())
+			}
 		}
 
 		if property.Type == 0 {
@@ -26,7 +26,7 @@ import (
 
 // CBindingTemplate is used to generated the binding code
 var CBindingTemplate = template.Must(template.New("binding-c").Funcs(funcMap).Parse(
-	`// Code generated by ObjectBox; DO NOT EDIT.
+	`{{.Banner}}
 
 #pragma once
 
@@ -52,9 +52,9 @@ static flatbuffers_voffset_t {{.FileIdentifier}}_fb_field_offset(flatbuffers_vof
 {{PrintComments 0 $entity.Comments}}typedef struct {{$entity.Meta.CName}} {
 	{{range $property := $entity.Properties}}{{$propType := PropTypeName $property.Type -}}
 	{{PrintComments 1 $property.Comments}}{{if $property.Meta.FbIsVector}}{{$property.Meta.CElementType}}* {{$property.Meta.CppName}};
-	{{- if or (or (eq $propType "StringVector") (eq $propType "ByteVector")) (eq $propType "FloatVector")}}
+	{{- if not (eq $propType "String")}}
 	size_t {{$property.Meta.CppName}}_len;{{end}}
-	{{else}}{{$property.Meta.CppType}}{{if $property.Meta.Optional}}*{{end}} {{$property.Meta.CppName}};
+	{{else}}{{$property.Meta.CppType}}{{if $property.Meta.Optional}}*{{end}} {{$property.Meta.CppName}};{{with $property.Meta.EnumName}} ///< enum {{.}}{{end}}
 	{{end}}{{end}}
 } {{$entity.Meta.CName}};
 
@@ -88,6 +88,21 @@ static void {{$entity.Meta.CName}}_free_pointers({{$entity.Meta.CName}}* object)
 /// Free {{$entity.Meta.CName}}* object pointer and all its property pointers (vectors and strings).
 /// Equivalent to calling {{$entity.Meta.CName}}_free_pointers() followed by free();
 static void {{$entity.Meta.CName}}_free({{$entity.Meta.CName}}* object);
+
+/// Compare two objects property by property, including the contents of vectors and strings.
+/// Two NULL pointers (objects or properties) are considered equal, a NULL and a non-NULL one are not.
+static bool {{$entity.Meta.CName}}_equal(const {{$entity.Meta.CName}}* a, const {{$entity.Meta.CName}}* b);
+
+/// Copy all properties of src to dst, allocating new memory for vectors and strings, thus dst doesn't share any memory
+/// with src and both must be freed separately by calling {{$entity.Meta.CName}}_free_pointers() (or {{$entity.Meta.CName}}_free()).
+/// Any pointers previously held by dst are overwritten, not freed.
+/// @returns true if the object was copied successfully or false on allocation error in which case any memory
+///          allocated by this function will also be freed before returning.
+static bool {{$entity.Meta.CName}}_copy(const {{$entity.Meta.CName}}* src, {{$entity.Meta.CName}}* dst);
+
+/// Reset the object to an empty state: NULL pointers, zero vector lengths and zero scalars.
+/// Doesn't free any memory, call {{$entity.Meta.CName}}_free_pointers() before to reuse an object without leaks.
+static void {{$entity.Meta.CName}}_zero({{$entity.Meta.CName}}* object);
 {{end}}
 {{- range $entity := .Model.EntitiesWithMeta}}
 static bool {{$entity.Meta.CName}}_to_flatbuffer(flatcc_builder_t* B, const {{$entity.Meta.CName}}* object, void** out_buffer, size_t* out_size) {
@@ -101,10 +116,8 @@ static bool {{$entity.Meta.CName}}_to_flatbuffer(flatcc_builder_t* B, const {{$e
 	{{range $property := $entity.Properties}}{{$propType := PropTypeName $property.Type}}
 	{{- if eq $propType "String"}}
 	flatcc_builder_ref_t offset_{{$property.Meta.CppName}} = !object->{{$property.Meta.CppName}} ? 0 : flatcc_builder_create_string_str(B, object->{{$property.Meta.CppName}});
-	{{- else if eq $propType "ByteVector"}}
-	flatcc_builder_ref_t offset_{{$property.Meta.CppName}} = !object->{{$property.Meta.CppName}} ? 0 : flatcc_builder_create_vector(B, object->{{$property.Meta.CppName}}, object->{{$property.Meta.CppName}}_len, sizeof({{$property.Meta.CElementType}}), sizeof({{$property.Meta.CElementType}}), FLATBUFFERS_COUNT_MAX(sizeof({{$property.Meta.CElementType}})));
-	{{- else if eq $propType "FloatVector"}}
-	flatcc_builder_ref_t offset_{{$property.Meta.CppName}} = !object->{{$property.Meta.CppName}} ? 0 : flatcc_builder_create_vector(B, object->{{$property.Meta.CppName}}, object->{{$property.Meta.CppName}}_len, sizeof({{$property.Meta.CElementType}}), sizeof({{$property.Meta.CElementType}}), FLATBUFFERS_COUNT_MAX(sizeof({{$property.Meta.CElementType}})));
+	{{- else if and $property.Meta.FbIsVector (not (eq $propType "StringVector"))}}
+	flatcc_builder_ref_t offset_{{$property.Meta.CppName}} = !object->{{$property.Meta.CppName}} ? 0 : flatcc_builder_create_vector(B, object->{{$property.Meta.CppName}}, object->{{$property.Meta.CppName}}_len, sizeof({{$property.Meta.CElementType}}), {{$property.Meta.FbVectorAlignment $.VectorAlignment}}, FLATBUFFERS_COUNT_MAX(sizeof({{$property.Meta.CElementType}})));
 	{{- else if eq $propType "StringVector"}}
 	flatcc_builder_ref_t offset_{{$property.Meta.CppName}} = 0;
 	if (object->{{$property.Meta.CppName}}) {
@@ -176,7 +189,7 @@ static bool {{$entity.Meta.CName}}_from_flatbuffer(const void* data, size_t size
 		{{/*Note: direct copy for string and byte vectors*/}}
 		{{if eq $propType "String"}}memcpy((void*)out_object->{{$property.Meta.CppName}}, (const void*)val, len+1);
 		{{else if eq $propType "ByteVector"}}memcpy((void*)out_object->{{$property.Meta.CppName}}, (const void*)val, len);
-		{{else if eq $propType "FloatVector"}}memcpy((void*)out_object->{{$property.Meta.CppName}}, (const void*)val, sizeof(float)*len);
+		{{else if not (eq $propType "StringVector")}}memcpy((void*)out_object->{{$property.Meta.CppName}}, (const void*)val, sizeof({{$property.Meta.CElementType}})*len);
 		{{else}}{{/* StringVector - FB vector contains offsets to strings, each must be read separately*/ -}}
 		for (size_t i = 0; i < len; i++, val++) {
 			const uint8_t* str = (const uint8_t*) val + (size_t)__flatbuffers_uoffset_read_from_pe(val) + sizeof(val[0]);
@@ -247,6 +260,97 @@ static void {{$entity.Meta.CName}}_free({{$entity.Meta.CName}}* object) {
 	free(object);
 }
 
+static void {{$entity.Meta.CName}}_zero({{$entity.Meta.CName}}* object) {
+	assert(object);
+#ifdef __cplusplus
+	*object = {};
+#else
+	*object = ({{$entity.Meta.CName}}){0};
+#endif
+}
+
+static bool {{$entity.Meta.CName}}_equal(const {{$entity.Meta.CName}}* a, const {{$entity.Meta.CName}}* b) {
+	if (a == b) return true;
+	if (a == NULL || b == NULL) return false;
+	{{range $property := $entity.Properties}}{{$propType := PropTypeName $property.Type}}{{$name := $property.Meta.CppName -}}
+	{{if $property.Meta.FbIsVector -}}
+	if ((a->{{$name}} == NULL) != (b->{{$name}} == NULL)) return false;
+	{{- if eq $propType "String"}}
+	if (a->{{$name}} && strcmp(a->{{$name}}, b->{{$name}}) != 0) return false;
+	{{- else}}
+	if (a->{{$name}}_len != b->{{$name}}_len) return false;
+	{{- if eq $propType "StringVector"}}
+	for (size_t i = 0; a->{{$name}} && i < a->{{$name}}_len; i++) {
+		if ((a->{{$name}}[i] == NULL) != (b->{{$name}}[i] == NULL)) return false;
+		if (a->{{$name}}[i] && strcmp(a->{{$name}}[i], b->{{$name}}[i]) != 0) return false;
+	}
+	{{- else}}
+	if (a->{{$name}} && memcmp(a->{{$name}}, b->{{$name}}, a->{{$name}}_len * sizeof({{$property.Meta.CElementType}})) != 0) return false;
+	{{- end}}{{end}}
+	{{else if $property.Meta.Optional -}}
+	if ((a->{{$name}} == NULL) != (b->{{$name}} == NULL)) return false;
+	if (a->{{$name}} && *a->{{$name}} != *b->{{$name}}) return false;
+	{{else -}}
+	if (a->{{$name}} != b->{{$name}}) return false;
+	{{end}}
+	{{- end}}return true;
+}
+
+static bool {{$entity.Meta.CName}}_copy(const {{$entity.Meta.CName}}* src, {{$entity.Meta.CName}}* dst) {
+	assert(src);
+	assert(dst);
+	assert(src != dst);
+
+	// copy scalars and reset pointers so that a partial copy can be freed properly on malloc() failures
+	*dst = *src;
+	{{- range $property := $entity.Properties}}{{$propType := PropTypeName $property.Type}}{{if or $property.Meta.FbIsVector $property.Meta.Optional}}
+	dst->{{$property.Meta.CppName}} = NULL;
+	{{- if and $property.Meta.FbIsVector (not (eq $propType "String"))}}
+	dst->{{$property.Meta.CppName}}_len = 0;
+	{{- end}}{{end}}{{end}}
+	{{range $property := $entity.Properties}}{{$propType := PropTypeName $property.Type}}{{$name := $property.Meta.CppName -}}
+	{{if $property.Meta.FbIsVector -}}
+	if (src->{{$name}}) {
+		{{- if eq $propType "String"}}
+		dst->{{$name}} = (char*) malloc((strlen(src->{{$name}}) + 1) * sizeof(char));
+		{{- else}}{{/* allocate at least one element so that an empty vector stays distinguishable from NULL */}}
+		dst->{{$name}} = ({{$property.Meta.CElementType}}*) malloc((src->{{$name}}_len ? src->{{$name}}_len : 1) * sizeof({{$property.Meta.CElementType}}));
+		{{- end}}
+		if (dst->{{$name}} == NULL) {
+			{{$entity.Meta.CName}}_free_pointers(dst);
+			return false;
+		}
+		{{- if eq $propType "String"}}
+		strcpy(dst->{{$name}}, src->{{$name}});
+		{{- else if eq $propType "StringVector"}}
+		for (size_t i = 0; i < src->{{$name}}_len; i++, dst->{{$name}}_len++) {
+			dst->{{$name}}[i] = NULL;
+			if (src->{{$name}}[i] == NULL) continue;
+			dst->{{$name}}[i] = (char*) malloc((strlen(src->{{$name}}[i]) + 1) * sizeof(char));
+			if (dst->{{$name}}[i] == NULL) {
+				{{$entity.Meta.CName}}_free_pointers(dst);  // only free() indexes before the current "i"
+				return false;
+			}
+			strcpy(dst->{{$name}}[i], src->{{$name}}[i]);
+		}
+		{{- else}}
+		memcpy((void*)dst->{{$name}}, (const void*)src->{{$name}}, src->{{$name}}_len * sizeof({{$property.Meta.CElementType}}));
+		dst->{{$name}}_len = src->{{$name}}_len;
+		{{- end}}
+	}
+	{{else if $property.Meta.Optional -}}
+	if (src->{{$name}}) {
+		dst->{{$name}} = ({{$property.Meta.CppType}}*) malloc(sizeof({{$property.Meta.CppType}}));
+		if (dst->{{$name}} == NULL) {
+			{{$entity.Meta.CName}}_free_pointers(dst);
+			return false;
+		}
+		*dst->{{$name}} = *src->{{$name}};
+	}
+	{{end}}
+	{{- end}}return true;
+}
+
 /// Insert or update the given object in the database.
 /// @param object (in & out) will be updated with a newly inserted ID if the one specified previously was zero. If an ID 
 /// was already specified (non-zero), it will remain unchanged.
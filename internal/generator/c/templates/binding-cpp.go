@@ -26,7 +26,7 @@ import (
 
 // CppBindingTemplate is used to generated the binding code
 var CppBindingTemplate = template.Must(template.New("binding-cpp").Funcs(funcMap).Parse(
-	`// Code generated by ObjectBox; DO NOT EDIT.
+	`{{.Banner}}
 
 {{define "field-value"}}{{if .Optional}}*{{end}}object.{{.CppName}}{{end -}}
 {{define "field-value-assign-pre"}}{{if IsOptionalPtr .Optional}}.reset(new {{.CppType}}({{else}} = {{end}}{{end -}}
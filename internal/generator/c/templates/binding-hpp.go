@@ -26,7 +26,7 @@ import (
 
 // CppBindingTemplateHeader is used to generated the binding code
 var CppBindingTemplateHeader = template.Must(template.New("binding-hpp").Funcs(funcMap).Parse(
-	`// Code generated by ObjectBox; DO NOT EDIT.
+	`{{.Banner}}
 
 #pragma once
 
@@ -37,6 +37,14 @@ var CppBindingTemplateHeader = template.Must(template.New("binding-hpp").Funcs(f
 {{- else if .Optional}}
 #include <memory>
 {{end}}
+{{- if .Accessors}}
+#include <utility>
+{{- end}}
+{{- if .Equality}}
+#include <functional>
+#include <string>
+#include <vector>
+{{- end}}
 
 #include "flatbuffers/flatbuffers.h"
 #include "objectbox.h"
@@ -48,8 +56,33 @@ var CppBindingTemplateHeader = template.Must(template.New("binding-hpp").Funcs(f
 struct {{$entity.Meta.CppName}}_;
 
 {{PrintComments 0 $entity.Comments}}struct {{$entity.Meta.CppName}} {
+	{{- if $.Accessors}}
+private:
+	{{- end}}
 	{{- range $property := $entity.Properties}}
-	{{PrintComments 1 $property.Comments}}{{$property.Meta.CppTypeWithOptional}} {{$property.Meta.CppName}};
+	{{PrintComments 1 $property.Comments}}{{$property.Meta.CppTypeWithOptional}} {{$property.Meta.CppName}};{{with $property.Meta.EnumName}} ///< enum {{.}}{{end}}
+	{{- end}}
+	{{- if $.Accessors}}
+
+public:
+	{{- range $property := $entity.Properties}}
+	const {{$property.Meta.CppTypeWithOptional}}& {{$property.Meta.CppGetterName}}() const { return {{$property.Meta.CppName}}; }
+	void {{$property.Meta.CppSetterName}}({{$property.Meta.CppTypeWithOptional}} value) { {{$property.Meta.CppName}} = std::move(value); }
+	{{- end}}
+	{{- end}}
+	{{- if $.Equality}}
+
+	bool operator==(const {{$entity.Meta.CppName}}& other) const {
+		return {{range $i, $property := $entity.Properties}}{{if $i}} &&
+			{{end}}
+			{{- if and $property.Meta.Optional (ne $property.Meta.Optional "std::optional") -}}
+			(!{{$property.Meta.CppName}} ? !other.{{$property.Meta.CppName}} : (other.{{$property.Meta.CppName}} && *{{$property.Meta.CppName}} == *other.{{$property.Meta.CppName}}))
+			{{- else -}}
+			{{$property.Meta.CppName}} == other.{{$property.Meta.CppName}}
+			{{- end}}{{end}};
+	}
+
+	bool operator!=(const {{$entity.Meta.CppName}}& other) const { return !(*this == other); }
 	{{- end}}
 
     struct _OBX_MetaInfo {
@@ -83,5 +116,39 @@ struct {{$entity.Meta.CppName}}_ {
 {{- end}}
 };
 {{with $entity.Meta.CppNamespaceEnd}}{{.}}{{end -}}
+{{- if $.Equality}}
+
+namespace std {
+/// Combines hashes of all properties, with value semantics for strings, vectors and optional values.
+template <>
+struct hash<::{{$entity.Meta.CppNamespacePrefix}}{{$entity.Meta.CppName}}> {
+	size_t operator()(const ::{{$entity.Meta.CppNamespacePrefix}}{{$entity.Meta.CppName}}& object) const {
+		size_t result = 0;
+		{{- range $property := $entity.Properties}}
+		{{- $value := print "object." $property.Meta.CppName}}{{if $.Accessors}}{{$value = print "object." $property.Meta.CppGetterName "()"}}{{end}}
+		{{- if $property.Meta.Optional}}
+		combine(result, static_cast<bool>({{$value}}));
+		if ({{$value}}) combine(result, *{{$value}});
+		{{- else}}
+		combine(result, {{$value}});
+		{{- end}}
+		{{- end}}
+		return result;
+	}
+
+private:
+	template <typename T>
+	static void combine(size_t& seed, const T& value) {
+		seed ^= std::hash<T>()(value) + 0x9e3779b9 + (seed << 6) + (seed >> 2);
+	}
+
+	template <typename T>
+	static void combine(size_t& seed, const std::vector<T>& values) {
+		combine(seed, values.size());
+		for (const T& value : values) combine(seed, value);
+	}
+};
+}  // namespace std
+{{- end -}}
 {{end}}
 `))
@@ -26,7 +26,7 @@ import (
 
 // ModelTemplate is used to generate the model initialization code
 var ModelTemplate = template.Must(template.New("model").Funcs(funcMap).Parse(
-	`// Code generated by ObjectBox; DO NOT EDIT.
+	`{{.Banner}}
 
 #pragma once
 
@@ -0,0 +1,84 @@
+/*
+ * ObjectBox Generator - a build time tool for ObjectBox
+ * Copyright (C) 2018-2024 ObjectBox Ltd. All rights reserved.
+ * https://objectbox.io
+ *
+ * This file is part of ObjectBox Generator.
+ *
+ * ObjectBox Generator is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ * ObjectBox Generator is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with ObjectBox Generator.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package generator
+
+import (
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/model"
+)
+
+// modelElement is an entity, property, index or relation of a model, identified by its IdUid, see snapshotModel()
+type modelElement struct {
+	kind  string // e.g. "entity", "index for property"
+	name  string // e.g. "Entity.property"
+	idUid model.IdUid
+}
+
+// snapshotModel lists all IDs/UIDs assigned in the model, in the model order
+func snapshotModel(modelInfo *model.ModelInfo) []modelElement {
+	var result []modelElement
+	for _, entity := range modelInfo.Entities {
+		result = append(result, modelElement{"entity", entity.Name, entity.Id})
+		for _, property := range entity.Properties {
+			var name = entity.Name + "." + property.Name
+			result = append(result, modelElement{"property", name, property.Id})
+			if property.IndexId != nil {
+				result = append(result, modelElement{"index for property", name, *property.IndexId})
+			}
+		}
+		for _, relation := range entity.Relations {
+			result = append(result, modelElement{"relation", entity.Name + "." + relation.Name, relation.Id})
+		}
+	}
+	return result
+}
+
+// logModelChanges reports the entities of the final model and the IDs/UIDs added or removed since the given snapshot
+func logModelChanges(options Options, before []modelElement, modelInfo *model.ModelInfo) {
+	if options.Logger == nil {
+		return
+	}
+
+	for _, entity := range modelInfo.Entities {
+		options.Logger.Printf("Entity %s %s: %d properties, %d relations", entity.Name, entity.Id, len(entity.Properties), len(entity.Relations))
+	}
+
+	var after = snapshotModel(modelInfo)
+	var contains = func(elements []modelElement, searched modelElement) bool {
+		for _, element := range elements {
+			if element.kind == searched.kind && element.idUid == searched.idUid {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, element := range after {
+		if !contains(before, element) {
+			options.Logger.Printf("Assigned new %s %s ID/UID %s", element.kind, element.name, element.idUid)
+		}
+	}
+
+	for _, element := range before {
+		if !contains(after, element) {
+			options.Logger.Printf("Removed %s %s ID/UID %s", element.kind, element.name, element.idUid)
+		}
+	}
+}
@@ -22,13 +22,17 @@
 package generator
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"math/rand"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/objectbox/objectbox-generator/v4/internal/generator/model"
@@ -44,6 +48,26 @@ const Version = "4.0.0-beta"
 // Internal generator changes that don't change the output (in an incompatible way) do not cause an increase.
 const VersionId = 6
 
+// DefaultGeneratedBy is the generator name used in the generated files' banner, see Options.GeneratedBy
+const DefaultGeneratedBy = "ObjectBox"
+
+// DefaultSuffix is inserted before the extension of the generated binding files, see Options.Suffix
+const DefaultSuffix = ".obx"
+
+// suffixRegexp limits Options.Suffix to a simple file name part so it can't be confused with a path or an extension
+var suffixRegexp = regexp.MustCompile(`^\.[a-zA-Z0-9_-]+$`)
+
+// generatedFileRegexp is the generated-file convention recognized by the Go tools, see https://golang.org/s/generatedcode
+var generatedFileRegexp = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// ValidateBanner checks the given banner is recognized as a generated file marker
+func ValidateBanner(banner string) error {
+	if !generatedFileRegexp.MatchString(banner) {
+		return fmt.Errorf("generated file banner '%s' doesn't match the Go convention %s", banner, generatedFileRegexp.String())
+	}
+	return nil
+}
+
 // ModelInfoFile returns the model info JSON file name in the given directory
 func ModelInfoFile(dir string) string {
 	return filepath.Join(dir, "objectbox-model.json")
@@ -60,8 +84,9 @@ type CodeGenerator interface {
 	// ModelFile returns the language-specific model source file for the given JSON info file path
 	ModelFile(forFile string, options Options) string
 
-	// IsGeneratedFile returns true if the given path is recognized as a file generated by this generator
-	IsGeneratedFile(file string) bool
+	// IsGeneratedFile returns true if the given path is recognized as a file generated by this generator, taking
+	// options.BindingSuffix() into account
+	IsGeneratedFile(file string, options Options) bool
 
 	// IsSourceFile returns true if the given path is recognized as an input file by this generator.
 	// E.g. for Go files, ending with ".go", and for C++ ending with ".fbs".
@@ -75,55 +100,125 @@ type CodeGenerator interface {
 
 	// WriteModelBindingFile generates and writes binding source code file for model setup
 	WriteModelBindingFile(options Options, mergedModel *model.ModelInfo) error
+
+	// SupportedTypes lists the source types recognized by this generator and how they're stored
+	SupportedTypes() []TypeMapping
+}
+
+// ConcurrentSourceParser is optionally implemented by a CodeGenerator able to parse multiple source files at once,
+// see Options.Parallel.
+type ConcurrentSourceParser interface {
+	// ParseSourceConcurrently reads the input file like ParseSource but may be called concurrently for different files,
+	// thus it mustn't change the generator state. The returned function, if not nil, is called right before the parsed
+	// model is merged, making the source file current, as if ParseSource had been called for it just now.
+	ParseSourceConcurrently(sourceFile string) (*model.ModelInfo, func(), error)
+}
+
+// TypeMapping describes how a source type is stored in the database, see CodeGenerator.SupportedTypes()
+type TypeMapping struct {
+	SourceType   string
+	PropertyType model.PropertyType
+	Flags        model.PropertyFlags
 }
 
-// WriteFile writes data to targetFile, while using permissions either from the targetFile or permSource
-func WriteFile(file string, data []byte, permSource string) error {
-	var perm os.FileMode
+// defaultFileMode is used for new generated files if the permission source doesn't exist (anymore)
+const defaultFileMode os.FileMode = 0644
+
+// WriteFile writes data to targetFile, while using permissions either from the targetFile or permSource, falling back to
+// 0644 if neither exists. Execute bits are never set on new files as the generated files are sources.
+// If the file already exists with exactly the same content, it's left untouched (preserving its modification time),
+// unless emitUnchanged is true.
+func WriteFile(file string, data []byte, permSource string, emitUnchanged bool) error {
+	var perm = defaultFileMode
 	// copy permissions either from the existing file or from the source file
 	if info, _ := os.Stat(file); info != nil {
+		if !emitUnchanged && info.Size() == int64(len(data)) {
+			if existing, err := ioutil.ReadFile(file); err == nil && bytes.Equal(existing, data) {
+				return nil
+			}
+		}
 		perm = info.Mode()
-	} else if info, err := os.Stat(permSource); info != nil {
+	} else if info, _ := os.Stat(permSource); info != nil {
 		perm = info.Mode()
-	} else {
-		return err
 	}
 
-	return ioutil.WriteFile(file, data, perm)
+	return ioutil.WriteFile(file, data, perm.Perm()&^0111)
 }
 
 // Process is the main API method of the package
 // it takes source file & model-information file paths and generates bindings (as a sibling file to the source file)
 func Process(options Options) error {
+	_, err := ProcessModel(options)
+	return err
+}
+
+// ProcessModel generates bindings like Process and returns the merged model, e.g. for tools inspecting the entities.
+// The returned model reflects the written model JSON file (or the one that would be written in case of a dry run).
+func ProcessModel(options Options) (*model.ModelInfo, error) {
 	var err error
 
+	if err = ValidateBanner(options.Banner()); err != nil {
+		return nil, err
+	}
+
+	if options.LineEndings != "" && options.LineEndings != LineEndingsLF && options.LineEndings != LineEndingsCRLF {
+		return nil, fmt.Errorf("invalid line endings '%s', expecting %s or %s", options.LineEndings, LineEndingsLF, LineEndingsCRLF)
+	}
+
+	if len(options.Suffix) != 0 && !suffixRegexp.MatchString(options.Suffix) {
+		return nil, fmt.Errorf("invalid suffix '%s', expecting a dot followed by letters, digits, '_' or '-', e.g. '.gen'", options.Suffix)
+	}
+
+	if options.Check {
+		// a dry run that doesn't print but collects the differences
+		options.DryRun = true
+		options.EmitUnchanged = false
+		options.staleFiles = make(map[string]string)
+	}
+
 	// Ensure output directory is existing or create
-	if len(options.OutPath) != 0 {
+	if len(options.OutPath) != 0 && !options.DryRun {
 		err := os.MkdirAll(options.OutPath, 0750)
 		if err != nil {
-			return fmt.Errorf("can't create output path '"+options.OutPath+"': %s", err)
+			return nil, fmt.Errorf("can't create output path '"+options.OutPath+"': %s", err)
 		}
 	}
 
 	// Ensure output header directory is existing or create
-	if len(options.OutHeadersPath) != 0 {
+	if len(options.OutHeadersPath) != 0 && !options.DryRun {
 		err := os.MkdirAll(options.OutHeadersPath, 0750)
 		if err != nil {
-			return fmt.Errorf("can't create output headers path '"+options.OutPath+"': %s", err)
+			return nil, fmt.Errorf("can't create output headers path '"+options.OutPath+"': %s", err)
 		}
 	}
 
+	if len(options.ModelInfoFile) == 0 {
+		options.ModelInfoFile = ModelInfoFile(filepath.Dir(options.InPath))
+	}
+
+	if err = checkModelFiles(options); err != nil {
+		return nil, err
+	}
+
+	var cleanPath string
 	if PathIsDirOrPattern(options.InPath) {
 		var additional string
-		var cleanPath = options.InPath
+		cleanPath = options.InPath
 		if len(options.OutPath) != 0 {
 			additional = "of output path (-out=" + options.OutPath + ") "
 			cleanPath = options.OutPath
 		}
-		fmt.Printf("Requested to generate for directory/pattern %s, performing an implicit cleanup %sfirst\n", options.InPath, additional)
-		err = Clean(options.CodeGenerator, cleanPath)
-		if err != nil {
-			return err
+		if options.DryRun {
+			// reported after the generation, only listing the files that wouldn't be generated again
+			options.dryRunFiles = make(map[string]bool)
+		} else if options.OutWriter == nil {
+			// only clean if the output goes to the file system; an OutWriter redirects it elsewhere, leaving files untouched
+			fmt.Printf("Requested to generate for directory/pattern %s, performing an implicit cleanup %sfirst\n", options.InPath, additional)
+			for _, gen := range options.CodeGenerators() {
+				if err = clean(gen, cleanPath, options); err != nil {
+					return nil, err
+				}
+			}
 		}
 	}
 
@@ -132,73 +227,232 @@ func Process(options Options) error {
 		options.Rand = rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
 	}
 
-	if len(options.ModelInfoFile) == 0 {
-		options.ModelInfoFile = ModelInfoFile(filepath.Dir(options.InPath))
-	}
-
 	var modelInfo *model.ModelInfo
 
-	modelInfo, err = model.LoadOrCreateModel(options.ModelInfoFile)
+	if options.DryRun {
+		modelInfo, err = model.LoadOrCreateModelInMemory(options.ModelInfoFile)
+	} else {
+		modelInfo, err = model.LoadOrCreateModel(options.ModelInfoFile)
+	}
 	if err != nil {
-		return fmt.Errorf("can't init ModelInfo: %s", err)
+		return nil, fmt.Errorf("can't init ModelInfo: %s", err)
 	}
 
 	modelInfo.Rand = options.Rand
 	defer modelInfo.Close()
 
 	if err = modelInfo.Validate(); err != nil {
-		return fmt.Errorf("invalid ModelInfo loaded: %s", err)
+		return nil, fmt.Errorf("invalid ModelInfo loaded: %s", err)
 	}
 
 	// if the model is valid, upgrade it to the latest version
 	modelInfo.MinimumParserVersion = model.ModelVersion
 	modelInfo.ModelVersion = model.ModelVersion
 
-	if err = createBinding(options, modelInfo); err != nil {
-		return err
+	var snapshot []modelElement
+	if options.Logger != nil {
+		snapshot = snapshotModel(modelInfo)
+	}
+
+	// all generators merge their sources into the same model, each binding is written after the model is finalized
+	for k, gen := range options.CodeGenerators() {
+		if k > 0 {
+			// sources for another language may declare the same entities, they're not duplicates
+			clearMeta(modelInfo)
+		}
+		if err = createBinding(options.withCodeGenerator(gen), modelInfo); err != nil {
+			return nil, err
+		}
 	}
 
 	if err = createModel(options, modelInfo); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	logModelChanges(options, snapshot, modelInfo)
+
+	if options.dryRunFiles != nil {
+		for _, gen := range options.CodeGenerators() {
+			if err = pathForEach(cleanPath, func(filePath string) error {
+				if isGeneratedFile(gen, options, filePath) && !options.dryRunFiles[filepath.Clean(filePath)] {
+					options.reportDryRun("remove", filePath)
+				}
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = options.staleFilesError(); err != nil {
+		return nil, err
+	}
+
+	return modelInfo, nil
 }
 
 func createBinding(options Options, storedModel *model.ModelInfo) error {
-	return pathForEach(options.InPath, func(filePath string) error {
-		if !options.CodeGenerator.IsSourceFile(filePath) {
-			return nil
+	var sourceFiles []string
+	if err := pathForEach(options.InPath, func(filePath string) error {
+		if options.CodeGenerator.IsSourceFile(filePath) && !isGeneratedFile(options.CodeGenerator, options, filePath) {
+			sourceFiles = append(sourceFiles, filePath)
 		}
+		return nil
+	}); err != nil {
+		return err
+	}
 
-		// clear meta information from the previous createBinding() call (when processing multiple files at once)
-		for _, entity := range storedModel.EntitiesWithMeta() {
-			entity.Meta = nil
-		}
+	var parse = options.CodeGenerator.ParseSource
+	if parser, isConcurrent := options.CodeGenerator.(ConcurrentSourceParser); isConcurrent && options.Parallel > 1 && len(sourceFiles) > 1 {
+		var parsed = parseConcurrently(parser, sourceFiles, options.Parallel)
+		defer parsed.stop()
+		parse = parsed.get
+	}
 
-		currentModel, err := options.CodeGenerator.ParseSource(filePath)
-		if err != nil {
+	for _, filePath := range sourceFiles {
+		if err := mergeSource(options, storedModel, filePath, parse); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		if err = mergeBindingWithModelInfo(currentModel, storedModel); err != nil {
-			return fmt.Errorf("can't merge model information: %s", err)
-		}
+// mergeSource merges a single (parsed) source file into the stored model and writes its bindings.
+// Source files are always merged one by one, in a stable order, so that IDs/UIDs are assigned deterministically.
+func mergeSource(options Options, storedModel *model.ModelInfo, filePath string, parse func(string) (*model.ModelInfo, error)) error {
+	// clear meta information from the previous mergeSource() call (when processing multiple files at once)
+	for _, entity := range storedModel.EntitiesWithMeta() {
+		entity.Meta = nil
+	}
 
-		if err = storedModel.Finalize(); err != nil {
-			return fmt.Errorf("model finalization failed: %s", err)
-		}
+	currentModel, err := parse(filePath)
+	if err != nil {
+		return err
+	}
 
-		if err = options.CodeGenerator.WriteBindingFiles(filePath, options, storedModel); err != nil {
-			return err
+	// all matched source files (e.g. recursively, in multiple directories) are merged into the same model
+	if err = mergeBindingWithModelInfo(filePath, currentModel, storedModel); err != nil {
+		return fmt.Errorf("can't merge model information: %s", err)
+	}
+
+	if err = storedModel.Finalize(); err != nil {
+		return fmt.Errorf("model finalization failed: %s", err)
+	}
+
+	if err = checkIdOnlyEntities(options, storedModel.EntitiesWithMeta()); err != nil {
+		return err
+	}
+
+	if err = options.CodeGenerator.WriteBindingFiles(filePath, options, storedModel); err != nil {
+		return err
+	}
+
+	for _, entity := range storedModel.EntitiesWithMeta() {
+		entity.CurrentlyPresent = true
+	}
+
+	return nil
+}
+
+// concurrentParse holds the results of source files parsed by a pool of workers, see parseConcurrently()
+type concurrentParse struct {
+	results  map[string]*parsedSource
+	stopping chan struct{}
+	workers  sync.WaitGroup
+}
+
+type parsedSource struct {
+	model    *model.ModelInfo
+	activate func()
+	err      error
+	done     chan struct{}
+}
+
+// parseConcurrently starts parsing the given source files, in their order, using the given number of workers.
+// stop() must be called when the results aren't needed anymore, e.g. after a merge error.
+func parseConcurrently(parser ConcurrentSourceParser, sourceFiles []string, workers int) *concurrentParse {
+	var parsed = &concurrentParse{
+		results:  make(map[string]*parsedSource, len(sourceFiles)),
+		stopping: make(chan struct{}),
+	}
+
+	var queue = make(chan string, len(sourceFiles))
+	for _, sourceFile := range sourceFiles {
+		parsed.results[sourceFile] = &parsedSource{done: make(chan struct{})}
+		queue <- sourceFile
+	}
+	close(queue)
+
+	if workers > len(sourceFiles) {
+		workers = len(sourceFiles)
+	}
+
+	parsed.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer parsed.workers.Done()
+			for sourceFile := range queue {
+				select {
+				case <-parsed.stopping:
+					return
+				default:
+				}
+				var result = parsed.results[sourceFile]
+				result.model, result.activate, result.err = parser.ParseSourceConcurrently(sourceFile)
+				close(result.done)
+			}
+		}()
+	}
+	return parsed
+}
+
+// get waits until the given source file is parsed and makes it current in the generator
+func (parsed *concurrentParse) get(sourceFile string) (*model.ModelInfo, error) {
+	var result = parsed.results[sourceFile]
+	<-result.done
+	if result.err != nil {
+		return nil, result.err
+	}
+	if result.activate != nil {
+		result.activate()
+	}
+	return result.model, nil
+}
+
+// stop makes the workers skip the source files not yet parsed and waits for them to finish
+func (parsed *concurrentParse) stop() {
+	close(parsed.stopping)
+	parsed.workers.Wait()
+}
+
+// clearMeta removes meta information of entities, properties and relations, set while merging parsed sources.
+func clearMeta(modelInfo *model.ModelInfo) {
+	for _, entity := range modelInfo.Entities {
+		entity.Meta = nil
+		entity.DeclaredIn = ""
+		for _, property := range entity.Properties {
+			property.Meta = nil
 		}
+		for _, relation := range entity.Relations {
+			relation.Meta = nil
+		}
+	}
+}
 
-		for _, entity := range storedModel.EntitiesWithMeta() {
-			entity.CurrentlyPresent = true
+// checkIdOnlyEntities reports entities without any property (or relation) besides the ID - usually a modeling mistake.
+// It's a warning by default and an error with options.Strict.
+func checkIdOnlyEntities(options Options, entities []*model.Entity) error {
+	for _, entity := range entities {
+		if len(entity.Properties) > 1 || len(entity.Relations) > 0 {
+			continue
 		}
 
-		return nil
-	})
+		if options.Strict {
+			return fmt.Errorf("entity %s has no properties besides the ID", entity.Name)
+		}
+		log.Printf("Warning - entity %s has no properties besides the ID", entity.Name)
+	}
+	return nil
 }
 
 func createModel(options Options, modelInfo *model.ModelInfo) error {
@@ -207,7 +461,11 @@ func createModel(options Options, modelInfo *model.ModelInfo) error {
 		removedEntities := make([]*model.Entity, 0)
 		for _, entity := range modelInfo.Entities {
 			if !entity.CurrentlyPresent {
-				fmt.Printf("Removing missing entity %s %s from the model\n", entity.Name, entity.Id)
+				if options.DryRun {
+					fmt.Printf("Would remove missing entity %s %s from the model\n", entity.Name, entity.Id)
+				} else {
+					fmt.Printf("Removing missing entity %s %s from the model\n", entity.Name, entity.Id)
+				}
 				removedEntities = append(removedEntities, entity)
 			}
 		}
@@ -223,18 +481,62 @@ func createModel(options Options, modelInfo *model.ModelInfo) error {
 		}
 	}
 
-	if err := modelInfo.Write(); err != nil {
+	// only checked after all source files have been merged, the target may be declared in any of them
+	if err := modelInfo.CheckRelationTargets(); err != nil {
+		return fmt.Errorf("model finalization failed: %s", err)
+	}
+
+	if options.Logger != nil && !options.DryRun {
+		options.Logger.Printf("Writing %s", options.ModelInfoFile)
+	}
+
+	if options.DryRun {
+		data, err := modelInfo.Marshal()
+		if err != nil {
+			return fmt.Errorf("can't serialize model-info: %s", err)
+		}
+		options.reportDryRunWrite(options.ModelInfoFile, data, false)
+	} else if err := modelInfo.Write(); err != nil {
 		return fmt.Errorf("can't write model-info file %s: %s", options.ModelInfoFile, err)
 	}
 
-	return options.CodeGenerator.WriteModelBindingFile(options, modelInfo)
+	for _, gen := range options.CodeGenerators() {
+		if err := gen.WriteModelBindingFile(options.withCodeGenerator(gen), modelInfo); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Clean removes generated files in the given path.
-// Removes *.obx.* and objectbox-model.[go|h|...] but keeps objectbox-model.json
+// Removes *.obx.* and objectbox-model.[go|h|...] but keeps objectbox-model.json.
+// Files without the generated-file banner are kept, see Options.Force.
 func Clean(codeGenerator CodeGenerator, path string) error {
+	return clean(codeGenerator, path, Options{})
+}
+
+// CleanWithOptions removes files generated by any of the options.CodeGenerators() in options.InPath, like Clean().
+// Additionally, it recognizes the model file named after a custom options.ModelInfoFile and supports options.DryRun.
+// Like with options.DryRun, the files are only printed if options.OutWriter is set.
+func CleanWithOptions(options Options) error {
+	if len(options.ModelInfoFile) == 0 {
+		options.ModelInfoFile = ModelInfoFile(filepath.Dir(options.InPath))
+	}
+	for _, codeGenerator := range options.CodeGenerators() {
+		if err := clean(codeGenerator, options.InPath, options.withCodeGenerator(codeGenerator)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func clean(codeGenerator CodeGenerator, path string, options Options) error {
 	return pathForEach(path, func(filePath string) error {
-		if !codeGenerator.IsGeneratedFile(filePath) {
+		if !isGeneratedFile(codeGenerator, options, filePath) || !canRemove(options, filePath) {
+			return nil
+		}
+		if options.DryRun || options.OutWriter != nil {
+			fmt.Printf("Would remove %s\n", filePath)
 			return nil
 		}
 		fmt.Printf("Removing %s\n", filePath)
@@ -242,6 +544,121 @@ func Clean(codeGenerator CodeGenerator, path string) error {
 	})
 }
 
+// isGeneratedFile recognizes files generated by the given CodeGenerator, including the model file, which is named after
+// options.ModelInfoFile (e.g. "orders-model.json" results in "orders-model.go"), if set.
+// A custom-named model file is only recognized if it starts with the generated file banner, so it's never confused with
+// a source file of the same name.
+func isGeneratedFile(codeGenerator CodeGenerator, options Options, file string) bool {
+	if codeGenerator.IsGeneratedFile(file, options) {
+		return true
+	}
+	return len(options.ModelInfoFile) > 0 &&
+		filepath.Clean(file) == filepath.Clean(codeGenerator.ModelFile(options.ModelInfoFile, options)) &&
+		hasGeneratedBanner(file)
+}
+
+// hasGeneratedBanner checks whether the first line of the given file is a generated-file banner, see ValidateBanner().
+// Surrounding whitespace is ignored: Go files generated by previous versions have a trailing space after the banner.
+func hasGeneratedBanner(file string) bool {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return false
+	}
+	var firstLine = strings.TrimSpace(strings.SplitN(string(data), "\n", 2)[0])
+	return generatedFileRegexp.MatchString(firstLine)
+}
+
+// canRemove protects files matching the generated-file naming but missing the banner, unless options.Force is set
+func canRemove(options Options, file string) bool {
+	if options.Force || hasGeneratedBanner(file) {
+		return true
+	}
+	log.Printf("Warning - not removing %s: it doesn't start with a generated-file banner, use -force to remove it anyway", file)
+	return false
+}
+
+// checkModelFiles prevents a custom-named model file from overwriting a file that wasn't generated, e.g. a source file.
+func checkModelFiles(options Options) error {
+	for _, codeGenerator := range options.CodeGenerators() {
+		var modelFile = codeGenerator.ModelFile(options.ModelInfoFile, options.withCodeGenerator(codeGenerator))
+		if _, err := os.Stat(modelFile); err == nil && !isGeneratedFile(codeGenerator, options.withCodeGenerator(codeGenerator), modelFile) {
+			return fmt.Errorf("model file %s (named after the model info file %s) already exists and wasn't generated, "+
+				"choose a different model info file name", modelFile, options.ModelInfoFile)
+		}
+	}
+	return nil
+}
+
+// CleanOrphans removes only the generated files that don't have a corresponding source file (e.g. the source was deleted).
+// Expected files are computed from the current sources in options.InPath using CodeGenerator's BindingFiles() and ModelFile().
+// Generated files are looked up in options.OutPath (and options.OutHeadersPath) if given, otherwise in options.InPath.
+// With AdditionalCodeGenerators, files expected by any of the generators are kept.
+// With options.DryRun or options.OutWriter, the orphaned files are only printed.
+func CleanOrphans(options Options) error {
+	var expected = make(map[string]bool)
+
+	// model file doesn't belong to a single source file, it's kept as long as there's the model info file
+	if len(options.ModelInfoFile) == 0 {
+		options.ModelInfoFile = ModelInfoFile(filepath.Dir(options.InPath))
+	}
+	_, modelInfoErr := os.Stat(options.ModelInfoFile)
+
+	for _, codeGenerator := range options.CodeGenerators() {
+		var genOptions = options.withCodeGenerator(codeGenerator)
+		if modelInfoErr == nil {
+			expected[filepath.Clean(codeGenerator.ModelFile(genOptions.ModelInfoFile, genOptions))] = true
+		}
+
+		if err := pathForEach(options.InPath, func(filePath string) error {
+			if codeGenerator.IsSourceFile(filePath) && !isGeneratedFile(codeGenerator, genOptions, filePath) {
+				for _, file := range codeGenerator.BindingFiles(filePath, genOptions) {
+					expected[filepath.Clean(file)] = true
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	var isGeneratedFile = func(filePath string) bool {
+		for _, codeGenerator := range options.CodeGenerators() {
+			if isGeneratedFile(codeGenerator, options.withCodeGenerator(codeGenerator), filePath) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var cleanFn = func(filePath string) error {
+		if !isGeneratedFile(filePath) || expected[filepath.Clean(filePath)] || !canRemove(options, filePath) {
+			return nil
+		}
+
+		if options.DryRun || options.OutWriter != nil {
+			fmt.Printf("Would remove orphaned %s\n", filePath)
+			return nil
+		}
+		fmt.Printf("Removing orphaned %s\n", filePath)
+		return os.Remove(filePath)
+	}
+
+	var cleanPaths = []string{options.InPath}
+	if len(options.OutPath) != 0 {
+		cleanPaths = []string{options.OutPath}
+		if len(options.OutHeadersPath) != 0 {
+			cleanPaths = append(cleanPaths, options.OutHeadersPath)
+		}
+	}
+
+	for _, path := range cleanPaths {
+		if err := pathForEach(path, cleanFn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 const recursionSuffix = "/..."
 
 // PathIsDirOrPattern checks whether the given path is a path pattern, a directory or a single file.
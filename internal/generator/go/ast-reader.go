@@ -24,10 +24,13 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/constant"
+	"go/token"
 	"go/types"
 	"log"
 	"path"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -39,27 +42,39 @@ type uid = uint64
 type id = uint32
 
 var supportedEntityAnnotations = map[string]bool{
-	"name":      false, // TODO
+	"name":      true, // database name, see Entity.DbName()
 	"sync":      true,
 	"transient": true,
 	"uid":       true,
 }
 
 var supportedPropertyAnnotations = map[string]bool{
-	"-":            true,
-	"converter":    true,
-	"date":         true,
-	"date-nano":    true,
-	"id":           true,
-	"id-companion": true,
-	"index":        true,
-	"inline":       true,
-	"lazy":         true,
-	"link":         true,
-	"name":         true,
-	"type":         true,
-	"uid":          true,
-	"unique":       true,
+	"-":                                    true,
+	"assignable":                           true,
+	"converter":                            true,
+	"date":                                 true,
+	"date-nano":                            true,
+	"default":                              true,
+	"eager":                                true,
+	"hnsw-dimensions":                      true,
+	"hnsw-distance-type":                   true,
+	"hnsw-flags":                           true,
+	"hnsw-indexing-search-count":           true,
+	"hnsw-neighbors-per-node":              true,
+	"hnsw-reparation-backlink-probability": true,
+	"hnsw-vector-cache-hint-size-kb":       true,
+	"id":                                   true,
+	"id-companion":                         true,
+	"index":                                true,
+	"inline":                               true,
+	"lazy":                                 true,
+	"link":                                 true,
+	"name":                                 true,
+	"ordered":                              true,
+	"transient":                            true,
+	"type":                                 true,
+	"uid":                                  true,
+	"unique":                               true,
 }
 
 // astReader contains information about the processed set of Entities
@@ -72,6 +87,9 @@ type astReader struct {
 
 	err    error
 	source *file
+
+	// whether to-many relations without an explicit `lazy` or `eager` annotation are loaded lazily
+	lazyRelations bool
 }
 
 // Entity holds the model information necessary to generate the binding code
@@ -80,6 +98,9 @@ type Entity struct {
 
 	Fields []*Field // the tree of struct fields (necessary for embedded structs)
 
+	// dbName overrides the entity name in the database, set by the `name` annotation, see DbName()
+	dbName string
+
 	binding *astReader // parent
 }
 
@@ -98,12 +119,27 @@ type Property struct {
 	FbType      string
 	Converter   *string
 
+	// DefaultValue is a Go expression (literal or constant) used when reading objects stored without this property
+	DefaultValue string
+
+	// FloatFormat is set for float fields stored as strings using the built-in `converter(fmt=...)`
+	FloatFormat string
+	FloatType   string // declared field type
+	FloatBits   string // 32 or 64
+
+	// JsonType is the declared type of a struct or map field serialized as JSON using the built-in `type:json` converter
+	JsonType string
+
+	// StringId is set on an ID property declared as a string; it's stored as uint64 using a converter
+	StringId bool
+
 	// type casts for named types
 	CastOnRead  string
 	CastOnWrite string
 
 	GoField *Field // actual code field this property represents
 	Entity  *Entity
+	OrderOf *Field // set on the property storing the order of objects in an "ordered" to-many relation
 
 	annotations map[string]*binding.Annotation
 }
@@ -114,6 +150,12 @@ func (property *Property) Merge(mProperty *model.Property) model.PropertyMeta {
 	return property
 }
 
+// IsLoadedLazily implements model.LazyRelationMeta interface - a to-one relation declared as a plain ID field only
+// stores the target ID, the related object isn't read by the generated code.
+func (property *Property) IsLoadedLazily() bool {
+	return property.IsBasicType
+}
+
 // Field is a field in an entity-struct. Not all fields become properties (e.g. to-many relations don't have a property)
 type Field struct {
 	Entity             *Entity // parent entity
@@ -124,6 +166,7 @@ type Field struct {
 	Fields             []*Field                  // inner fields, nil if it's a property
 	StandaloneRelation *model.StandaloneRelation // to-many relation stored as a standalone relation in the model
 	IsLazyLoaded       bool                      // only standalone (to-many) relations currently support lazy loading
+	OrderProperty      *Property                 // stores the order of objects in an "ordered" to-many relation
 	Meta               *Field                    // self reference for recursive ".Meta.Fields" access in the template
 
 	path   string // relative addressing path for embedded structs
@@ -151,6 +194,19 @@ func (r *astReader) CreateFromAst(f *file) (err error) {
 		return r.err
 	}
 
+	return r.checkDbNames()
+}
+
+// checkDbNames verifies entity names in the database are unique, considering the `name` annotation
+func (r *astReader) checkDbNames() error {
+	var names = make(map[string]string)
+	for _, mEntity := range r.model.Entities {
+		var dbName = mEntity.Meta.(*Entity).DbName()
+		if other, exists := names[strings.ToLower(dbName)]; exists {
+			return fmt.Errorf("entities %s and %s have the same name in the database: %s", other, mEntity.Name, dbName)
+		}
+		names[strings.ToLower(dbName)] = mEntity.Name
+	}
 	return nil
 }
 
@@ -241,6 +297,7 @@ func (r *astReader) createEntityFromAst(strct *ast.StructType, name string, comm
 		idProp.Type = model.PropertyTypeLong
 		idPropMeta.FbType = "Uint64"
 		idPropMeta.GoType = "uint64"
+		idPropMeta.StringId = true
 
 		if idPropMeta.annotations["converter"] == nil {
 			var converter = "objectbox.StringIdConvert"
@@ -330,9 +387,23 @@ func (entity *Entity) addFields(parent *Field, fields fieldList, fieldPath, pref
 
 		children = append(children, field)
 
+		// checked before processing the type, otherwise a converted struct would be embedded instead
+		if property.annotations["converter"] != nil && property.annotations["type"] == nil {
+			return nil, propertyError(errors.New("type annotation has to be specified when using converters"), property)
+		}
+
 		if property.annotations["type"] != nil {
 			var annotatedType = property.annotations["type"].Value
-			if len(annotatedType) > 1 && annotatedType[0] == '*' {
+			if annotatedType == "json" && property.annotations["converter"] == nil {
+				// built-in converter, the name is assigned below when the final property name is known
+				if err := property.setJsonType(f); err != nil {
+					return nil, propertyError(err, property)
+				}
+				entity.binding.Imports["encoding/json"] = "encoding/json"
+				entity.binding.Imports["errors"] = "errors"
+				annotatedType = "[]byte"
+				property.annotations["type"] = &binding.Annotation{Value: annotatedType}
+			} else if len(annotatedType) > 1 && annotatedType[0] == '*' {
 				field.IsPointer = true
 				annotatedType = annotatedType[1:]
 			}
@@ -341,6 +412,12 @@ func (entity *Entity) addFields(parent *Field, fields fieldList, fieldPath, pref
 				return nil, propertyError(err, property)
 			}
 
+			if property.annotations["converter"] == nil && len(property.JsonType) == 0 {
+				if err := property.setTypeOverride(f); err != nil {
+					return nil, propertyError(err, property)
+				}
+			}
+
 		} else if innerStructFields, err := field.processType(f); err != nil {
 			return nil, propertyError(err, property)
 
@@ -398,6 +475,13 @@ func (entity *Entity) addFields(parent *Field, fields fieldList, fieldPath, pref
 				return nil, err
 			}
 
+			if property.annotations["ordered"] != nil {
+				if field.StandaloneRelation == nil {
+					return nil, propertyError(errors.New("ordered annotation is only supported on to-many relations"), property)
+				}
+				entity.addOrderProperty(field, prefix)
+			}
+
 			addImportPath() // for structs, we're explicitly using the type so add the import
 
 			delete(*recursionStack, field.Type)
@@ -413,30 +497,80 @@ func (entity *Entity) addFields(parent *Field, fields fieldList, fieldPath, pref
 		}
 
 		if property.annotations["converter"] != nil {
-			if property.annotations["type"] == nil {
-				return nil, propertyError(errors.New("type annotation has to be specified when using converters"), property)
+			if property.annotations["converter"].HasDetail("fmt") {
+				// built-in converter, the name is assigned below when the final property name is known
+				if err := property.setFloatFormat(f); err != nil {
+					return nil, propertyError(err, property)
+				}
+				entity.binding.Imports["fmt"] = "fmt"
+				entity.binding.Imports["strconv"] = "strconv"
+			} else {
+				property.Converter = &property.annotations["converter"].Value
 			}
-			property.Converter = &property.annotations["converter"].Value
 
 			// converters use errors.New in the template
 			entity.binding.Imports["errors"] = "errors"
 		}
 
+		if property.annotations["ordered"] != nil {
+			return nil, propertyError(errors.New("ordered annotation is only supported on to-many relations"), property)
+		}
+
 		if err := property.ProcessAnnotations(property.annotations); err != nil {
 			return nil, propertyError(err, property)
 		}
 
+		if property.annotations["default"] != nil {
+			if err := property.setDefaultValue(property.annotations["default"].Value); err != nil {
+				return nil, propertyError(err, property)
+			}
+		}
+
 		if len(prefix) != 0 {
 			property.ModelProperty.Name = prefix + "_" + property.ModelProperty.Name
 			property.Name = prefix + "_" + property.Name
 		}
 
+		if len(property.FloatFormat) != 0 {
+			var converter = strings.ToLower(entity.Name[0:1]) + entity.Name[1:] + "_" + property.Name + "Format"
+			property.Converter = &converter
+		} else if len(property.JsonType) != 0 {
+			var converter = strings.ToLower(entity.Name[0:1]) + entity.Name[1:] + "_" + property.Name + "Json"
+			property.Converter = &converter
+		}
+
 		entity.ModelEntity.Properties = append(entity.ModelEntity.Properties, modelProperty)
 	}
 
 	return children, nil
 }
 
+// addOrderProperty adds a property storing IDs of the related objects in the order of the given to-many relation field.
+// Standalone relations themselves are unordered, so this is an opt-in due to the additional storage.
+func (entity *Entity) addOrderProperty(field *Field, prefix string) {
+	var modelProperty = model.CreateProperty(entity.ModelEntity, 0, 0)
+	var property = &Property{
+		Field:   binding.CreateField(modelProperty),
+		Entity:  entity,
+		GoField: field,
+		OrderOf: field,
+	}
+	modelProperty.Meta = property
+
+	if len(prefix) != 0 {
+		property.SetName(prefix + "_" + field.Name + "Order")
+	} else {
+		property.SetName(field.Name + "Order")
+	}
+
+	if err := property.setBasicType("[]byte"); err != nil {
+		panic(err) // []byte is always a basic type
+	}
+
+	field.OrderProperty = property
+	entity.ModelEntity.Properties = append(entity.ModelEntity.Properties, modelProperty)
+}
+
 // processType analyzes field type information and configures it.
 // It might result in setting a field.Type (in case it's one of the basic types),
 // field.StandaloneRelation (in case of many-to-many relations) or field.SimpleRelation (one-to-many relations).
@@ -500,6 +634,15 @@ func (field *Field) processType(f field) (fields fieldList, err error) {
 	if slice, isSlice := baseType.(*types.Slice); isSlice {
 		var elementType = slice.Elem()
 
+		// the target must be an entity, i.e. a struct, either referenced by a pointer or stored by value
+		var elementBase = elementType.Underlying()
+		if pointer, isPointer := elementType.(*types.Pointer); isPointer {
+			elementBase = pointer.Elem().Underlying()
+		}
+		if _, isStruct := elementBase.(*types.Struct); !isStruct {
+			return nil, fmt.Errorf("unsupported slice element type %s, expecting an entity or a pointer to an entity for a to-many relation", typeBaseName(elementType.String()))
+		}
+
 		// it's a many-to-many relation
 		if err := property.setRelationAnnotation(typeBaseName(elementType.String()), true); err != nil {
 			return nil, err
@@ -513,11 +656,23 @@ func (field *Field) processType(f field) (fields fieldList, err error) {
 			return nil, err
 		} else {
 			field.StandaloneRelation = rel
+			rel.Meta = field
+		}
+
+		// relations only; an annotation overrides the generator-wide default
+		var lazy, eager = field.Property.annotations["lazy"] != nil, field.Property.annotations["eager"] != nil
+		if lazy && eager {
+			return nil, fmt.Errorf("lazy and eager annotations can't be used together")
 		}
+		field.IsLazyLoaded = lazy || (!eager && field.Entity.binding.lazyRelations)
 
-		if field.Property.annotations["lazy"] != nil {
-			// relations only
-			field.IsLazyLoaded = true
+		if property.annotations["ordered"] != nil {
+			// the order is written on each put so it must be known, i.e. the relation must have been loaded
+			if field.IsLazyLoaded {
+				return nil, fmt.Errorf("ordered relations must be loaded eagerly")
+			}
+			field.Entity.binding.Imports["encoding/binary"] = "encoding/binary"
+			field.Entity.binding.Imports["sort"] = "sort"
 		}
 
 		// fill in the field information
@@ -580,9 +735,28 @@ func (entity *Entity) setAnnotations(comments []*ast.Comment) error {
 		}
 	}
 
+	// the model (JSON) keeps identifying the entity by the Go type name so that other files (e.g. objectbox-model.go)
+	// can refer to the generated binding, only the name passed to the database is overridden
+	if annotations["name"] != nil {
+		if len(annotations["name"].Value) == 0 {
+			return fmt.Errorf("name annotation value must not be empty - it's the entity name in DB")
+		}
+		entity.dbName = annotations["name"].Value
+		delete(annotations, "name")
+	}
+
 	return entity.ProcessAnnotations(annotations)
 }
 
+// DbName called from the template. Returns the entity name in the database, which is the Go type name unless
+// overridden by the `name` annotation.
+func (entity *Entity) DbName() string {
+	if len(entity.dbName) > 0 {
+		return entity.dbName
+	}
+	return entity.ModelEntity.Name
+}
+
 func parseCommentsLines(comments []*ast.Comment) []string {
 	var lines []string
 
@@ -681,68 +855,253 @@ func parseAnnotations(tags string, annotations *map[string]*binding.Annotation,
 	return binding.ParseAnnotations(tags, annotations, supportedAnnotations)
 }
 
+// goBasicTypes lists supported Go basic types with the ObjectBox property type and the FlatBuffers type they're stored as
+var goBasicTypes = []struct {
+	goType  string
+	obxType model.PropertyType
+	fbType  string
+	flags   model.PropertyFlags
+}{
+	{"string", model.PropertyTypeString, "UOffsetT", 0},
+	{"int", model.PropertyTypeLong, "Int64", 0},
+	{"int64", model.PropertyTypeLong, "Int64", 0},
+	{"uint", model.PropertyTypeLong, "Uint64", model.PropertyFlagUnsigned},
+	{"uint64", model.PropertyTypeLong, "Uint64", model.PropertyFlagUnsigned},
+	{"int32", model.PropertyTypeInt, "Int32", 0},
+	{"rune", model.PropertyTypeInt, "Int32", 0},
+	{"uint32", model.PropertyTypeInt, "Uint32", model.PropertyFlagUnsigned},
+	{"int16", model.PropertyTypeShort, "Int16", 0},
+	{"uint16", model.PropertyTypeShort, "Uint16", model.PropertyFlagUnsigned},
+	{"int8", model.PropertyTypeByte, "Int8", 0},
+	{"uint8", model.PropertyTypeByte, "Uint8", model.PropertyFlagUnsigned},
+	{"byte", model.PropertyTypeByte, "Uint8", model.PropertyFlagUnsigned},
+	{"[]byte", model.PropertyTypeByteVector, "UOffsetT", 0},
+	{"[]float32", model.PropertyTypeFloatVector, "UOffsetT", 0},
+	{"[]string", model.PropertyTypeStringVector, "UOffsetT", 0},
+	{"float64", model.PropertyTypeDouble, "Float64", 0},
+	{"float32", model.PropertyTypeFloat, "Float32", 0},
+	{"bool", model.PropertyTypeBool, "Bool", 0},
+}
+
 func (property *Property) setBasicType(baseType string) error {
 	property.GoType = baseType
 	property.IsBasicType = true
 
-	ts := property.GoType
-	if property.GoType == "string" {
-		property.ModelProperty.Type = model.PropertyTypeString
-		property.FbType = "UOffsetT"
-	} else if ts == "int" || ts == "int64" {
-		property.ModelProperty.Type = model.PropertyTypeLong
-		property.FbType = "Int64"
-	} else if ts == "uint" || ts == "uint64" {
-		property.ModelProperty.Type = model.PropertyTypeLong
-		property.FbType = "Uint64"
-		property.ModelProperty.AddFlag(model.PropertyFlagUnsigned)
-	} else if ts == "int32" || ts == "rune" {
-		property.ModelProperty.Type = model.PropertyTypeInt
-		property.FbType = "Int32"
-	} else if ts == "uint32" {
-		property.ModelProperty.Type = model.PropertyTypeInt
-		property.FbType = "Uint32"
-		property.ModelProperty.AddFlag(model.PropertyFlagUnsigned)
-	} else if ts == "int16" {
-		property.ModelProperty.Type = model.PropertyTypeShort
-		property.FbType = "Int16"
-	} else if ts == "uint16" {
-		property.ModelProperty.Type = model.PropertyTypeShort
-		property.FbType = "Uint16"
-		property.ModelProperty.AddFlag(model.PropertyFlagUnsigned)
-	} else if ts == "int8" {
-		property.ModelProperty.Type = model.PropertyTypeByte
-		property.FbType = "Int8"
-	} else if ts == "uint8" || ts == "byte" {
-		property.ModelProperty.Type = model.PropertyTypeByte
-		property.FbType = "Uint8"
-		property.ModelProperty.AddFlag(model.PropertyFlagUnsigned)
-	} else if ts == "[]byte" {
-		property.ModelProperty.Type = model.PropertyTypeByteVector
-		property.FbType = "UOffsetT"
-	} else if ts == "[]float32" {
-		property.ModelProperty.Type = model.PropertyTypeFloatVector
-		property.FbType = "UOffsetT"
-	} else if ts == "[]string" {
-		property.ModelProperty.Type = model.PropertyTypeStringVector
-		property.FbType = "UOffsetT"
-	} else if ts == "float64" {
-		property.ModelProperty.Type = model.PropertyTypeDouble
-		property.FbType = "Float64"
-	} else if ts == "float32" {
-		property.ModelProperty.Type = model.PropertyTypeFloat
-		property.FbType = "Float32"
-	} else if ts == "bool" {
-		property.ModelProperty.Type = model.PropertyTypeBool
-		property.FbType = "Bool"
+	for _, t := range goBasicTypes {
+		if t.goType == property.GoType {
+			property.ModelProperty.Type = t.obxType
+			property.FbType = t.fbType
+			property.ModelProperty.AddFlag(t.flags)
+			return nil
+		}
+	}
+
+	property.IsBasicType = false
+	return fmt.Errorf("unknown type %s", property.GoType)
+}
+
+// setTypeOverride validates the `type` annotation used without a converter, changing the stored type of a basic field,
+// e.g. its width, and sets up the casts between the declared and the stored type
+func (property *Property) setTypeOverride(f field) error {
+	baseType, err := f.Type().UnderlyingOrError()
+	if err != nil {
+		return err
+	} else if baseType.String() == property.GoType {
+		return nil
+	} else if property.GoField.IsPointer {
+		return errors.New("type annotation without a converter isn't supported on pointer fields")
+	}
+
+	var declared, isBasic = baseType.(*types.Basic)
+	if !isBasic {
+		return fmt.Errorf("type annotation without a converter is only supported on basic types, found %s", baseType.String())
+	}
+
+	var stored *types.Basic
+	if obj := types.Universe.Lookup(property.GoType); obj != nil {
+		stored, _ = obj.Type().(*types.Basic)
+	}
+
+	// only the storage width (and signedness) may change; other conversions need a converter
+	const kinds = types.IsInteger | types.IsFloat | types.IsString | types.IsBoolean
+	if stored == nil || declared.Info()&kinds&^types.IsUnsigned != stored.Info()&kinds&^types.IsUnsigned {
+		return fmt.Errorf("type %s is incompatible with the field type %s, use a converter to store it as a different kind", property.GoType, baseType.String())
+	}
+
+	property.CastOnRead = property.GoType
+	property.CastOnWrite = path.Base(f.Type().String()) // sometimes, it may contain a full import path
+	return nil
+}
+
+// floatFormatRegexp matches a single floating-point fmt verb with optional flags, width and precision, e.g. %.2f
+var floatFormatRegexp = regexp.MustCompile(`^%[-+ #0]*[0-9]*(\.[0-9]+)?[eEfFgG]$`)
+
+// setFloatFormat validates the built-in converter storing a float field as a string, e.g. `type:string converter(fmt=%.2f)`
+func (property *Property) setFloatFormat(f field) error {
+	var format = strings.Trim(property.annotations["converter"].Details["fmt"].Value, "'")
+	if len(property.annotations["converter"].Value) != 0 {
+		return errors.New("converter with a fmt detail can't specify a converter name")
+	} else if !floatFormatRegexp.MatchString(format) {
+		return fmt.Errorf("invalid float format '%s', expecting a single floating-point verb, e.g. %%.2f", format)
+	} else if property.annotations["type"].Value != "string" {
+		return errors.New("converter with a fmt detail requires `type:string`")
+	} else if property.GoField.IsPointer {
+		return errors.New("converter with a fmt detail isn't supported on pointer fields")
+	}
+
+	if baseType, err := f.Type().UnderlyingOrError(); err != nil {
+		return err
+	} else if baseType.String() != "float32" && baseType.String() != "float64" {
+		return fmt.Errorf("converter with a fmt detail is only supported on float32 and float64 fields, found %s", baseType.String())
 	} else {
-		property.IsBasicType = false
-		return fmt.Errorf("unknown type %s", ts)
+		property.FloatBits = baseType.String()[len("float"):]
+	}
+
+	// the converter works with the declared field type, which may be a named type declared in the same package
+	var qualifierErr error
+	property.FloatType = types.TypeString(f.TypeInternal(), func(pkg *types.Package) string {
+		if pkg.Path() != property.Entity.binding.Package.Path() {
+			qualifierErr = fmt.Errorf("converter with a fmt detail doesn't support types declared in other packages, found %s", f.TypeInternal())
+		}
+		return ""
+	})
+	if qualifierErr != nil {
+		return qualifierErr
+	}
+
+	property.FloatFormat = format
+	return nil
+}
+
+// setJsonType validates the built-in converter storing a struct or a map field as JSON, e.g. `type:json`
+func (property *Property) setJsonType(f field) error {
+	baseType, err := f.Type().UnderlyingOrError()
+	if err != nil {
+		return err
+	}
+	if pointer, isPointer := baseType.(*types.Pointer); isPointer {
+		baseType = pointer.Elem().Underlying()
+	}
+
+	switch baseType.(type) {
+	case *types.Struct, *types.Map:
+	default:
+		return fmt.Errorf("type:json is only supported on struct and map fields, found %s", baseType.String())
+	}
+
+	// the converter works with the declared field type, which may be declared in another package
+	var entityPackage = property.Entity.binding.Package
+	property.JsonType = types.TypeString(f.TypeInternal(), func(pkg *types.Package) string {
+		if pkg.Path() == entityPackage.Path() {
+			return ""
+		}
+		if pkg.Name() == path.Base(pkg.Path()) {
+			property.Entity.binding.Imports[pkg.Path()] = pkg.Path()
+		} else {
+			property.Entity.binding.Imports[pkg.Name()] = pkg.Path()
+		}
+		return pkg.Name()
+	})
+	return nil
+}
+
+// defaultValueKinds lists property types supporting the `default` annotation
+var defaultValueKinds = map[string]types.BasicKind{
+	"bool":    types.Bool,
+	"int":     types.Int64, // stored as int64
+	"int8":    types.Int8,
+	"int16":   types.Int16,
+	"int32":   types.Int32,
+	"rune":    types.Int32,
+	"int64":   types.Int64,
+	"uint":    types.Uint64, // stored as uint64
+	"uint8":   types.Uint8,
+	"byte":    types.Uint8,
+	"uint16":  types.Uint16,
+	"uint32":  types.Uint32,
+	"uint64":  types.Uint64,
+	"float32": types.Float32,
+	"float64": types.Float64,
+}
+
+// setDefaultValue validates the `default` annotation value, which is either a literal or a package-level constant.
+func (property *Property) setDefaultValue(value string) error {
+	if len(value) == 0 {
+		return errors.New("default annotation value must not be empty")
 	}
 
+	var kind, supported = defaultValueKinds[property.GoType]
+	if !supported || property.ModelProperty.IsIdProperty() || property.Converter != nil || property.GoField.IsPointer ||
+		len(property.ModelProperty.RelationTarget) > 0 {
+		return errors.New("default annotation is only supported on numeric and bool (non-pointer) properties without a converter")
+	}
+
+	// a literal, e.g. 42, -1.5 or true
+	if tv, err := types.Eval(token.NewFileSet(), nil, token.NoPos, value); err == nil && tv.Value != nil {
+		if !isRepresentable(tv.Value, kind) {
+			return fmt.Errorf("default value %s doesn't fit the property type %s", value, property.GoType)
+		}
+		property.DefaultValue = value
+		return nil
+	}
+
+	// a package-level constant
+	var c = property.Entity.binding.source.packageConst(value)
+	if c == nil {
+		return fmt.Errorf("default value %s is neither a literal nor a package-level constant", value)
+	} else if !isRepresentable(c.Val(), kind) {
+		return fmt.Errorf("default value %s = %s doesn't fit the property type %s", value, c.Val(), property.GoType)
+	}
+
+	// reference the constant, converted to the type used by the FlatBuffers table getter
+	property.DefaultValue = property.FbGoType() + "(" + value + ")"
 	return nil
 }
 
+// isRepresentable checks whether the constant value can be converted to the given basic type without loss
+func isRepresentable(value constant.Value, kind types.BasicKind) bool {
+	switch kind {
+	case types.Bool:
+		return value.Kind() == constant.Bool
+	case types.Float32, types.Float64:
+		return value.Kind() == constant.Int || value.Kind() == constant.Float
+	}
+
+	if value.Kind() != constant.Int && value.Kind() != constant.Float {
+		return false
+	}
+	value = constant.ToInt(value)
+	if value.Kind() != constant.Int {
+		return false // a float with a fractional part
+	}
+
+	var bits = map[types.BasicKind]uint{
+		types.Int8: 8, types.Int16: 16, types.Int32: 32, types.Int64: 64,
+		types.Uint8: 8, types.Uint16: 16, types.Uint32: 32, types.Uint64: 64,
+	}[kind]
+
+	if kind == types.Uint8 || kind == types.Uint16 || kind == types.Uint32 || kind == types.Uint64 {
+		v, exact := constant.Uint64Val(value)
+		return exact && (bits == 64 || v < 1<<bits)
+	}
+	v, exact := constant.Int64Val(value)
+	return exact && (bits == 64 || (v >= -1<<(bits-1) && v < 1<<(bits-1)))
+}
+
+// FbGoType is called from the template. Returns the Go type of values returned by FlatBuffers table getters.
+func (property *Property) FbGoType() string {
+	return strings.ToLower(property.FbType)
+}
+
+// IsVector is called from the template. Returns true if the property is stored as a Go slice, e.g. []byte.
+func (property *Property) IsVector() bool {
+	switch property.ModelProperty.Type {
+	case model.PropertyTypeByteVector, model.PropertyTypeFloatVector, model.PropertyTypeStringVector:
+		return true
+	}
+	return false
+}
+
 // ObTypeString is called from the template
 func (property *Property) ObTypeString() string {
 	return model.PropertyTypeNames[property.ModelProperty.Type]
@@ -751,9 +1110,21 @@ func (property *Property) ObTypeString() string {
 // HasNonIdProperty called from the template. The goal is to void GO error "variable declared and not used"
 func (entity *Entity) HasNonIdProperty() bool {
 	// since every entity MUST have an ID property, just check whether there's more than one property...
+	// Note: this intentionally doesn't depend on the position of the ID field within the struct.
 	return len(entity.ModelEntity.Properties) > 1
 }
 
+// PropertiesRenamedInDb called from the template. Returns properties with a Go field path different from the name in DB.
+func (entity *Entity) PropertiesRenamedInDb() []*Property {
+	var result []*Property
+	for _, mProperty := range entity.ModelEntity.Properties {
+		if property := mProperty.Meta.(*Property); property.OrderOf == nil && property.Path() != mProperty.Name {
+			result = append(result, property)
+		}
+	}
+	return result
+}
+
 // HasRelations called from the template.
 func (entity *Entity) HasRelations() bool {
 	for _, field := range entity.Fields {
@@ -765,6 +1136,37 @@ func (entity *Entity) HasRelations() bool {
 	return false
 }
 
+// HasToOneRelations called from the template.
+func (entity *Entity) HasToOneRelations() bool {
+	for _, property := range entity.ModelEntity.Properties {
+		if len(property.RelationTarget) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PutAllUniqueProperties called from the template. Returns unique properties usable to match objects in PutAllUnique*,
+// i.e. direct (not embedded), non-pointer string or integer fields without a converter.
+func (entity *Entity) PutAllUniqueProperties() []*Property {
+	var result []*Property
+	for _, mProperty := range entity.ModelEntity.Properties {
+		if mProperty.IsIdProperty() || mProperty.Flags&model.PropertyFlagUnique == 0 || len(mProperty.RelationTarget) > 0 {
+			continue
+		}
+		var property = mProperty.Meta.(*Property)
+		if property.Converter != nil || property.GoField.IsPointer || property.GoField.parent != nil {
+			continue
+		}
+		switch property.GoType {
+		case "string", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "byte", "rune":
+			result = append(result, property)
+		}
+	}
+	return result
+}
+
 // HasLazyLoadedRelations called from the template.
 func (entity *Entity) HasLazyLoadedRelations() bool {
 	for _, field := range entity.Fields {
@@ -776,6 +1178,17 @@ func (entity *Entity) HasLazyLoadedRelations() bool {
 	return false
 }
 
+// Merge implements model.StandaloneRelationMeta interface
+func (field *Field) Merge(rel *model.StandaloneRelation) model.StandaloneRelationMeta {
+	field.StandaloneRelation = rel
+	return field
+}
+
+// IsLoadedLazily implements model.LazyRelationMeta interface
+func (field *Field) IsLoadedLazily() bool {
+	return field.IsLazyLoaded
+}
+
 // HasRelations called from the template.
 func (field *Field) HasRelations() bool {
 	if field.StandaloneRelation != nil || len(field.Property.ModelProperty.RelationTarget) > 0 {
@@ -791,6 +1204,11 @@ func (field *Field) HasRelations() bool {
 	return false
 }
 
+// HasPointerElements called from the template. Returns true for to-many relations declared as a slice of pointers.
+func (field *Field) HasPointerElements() bool {
+	return strings.HasPrefix(field.Type, "[]*")
+}
+
 // HasLazyLoadedRelations called from the template.
 func (field *Field) HasLazyLoadedRelations() bool {
 	if field.StandaloneRelation != nil && field.IsLazyLoaded {
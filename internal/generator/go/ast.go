@@ -184,6 +184,21 @@ func (f *file) getType(expr ast.Expr) (types.Type, error) {
 	return t, nil
 }
 
+// packageConst returns a package-level constant declared in the source package, or nil if there's no such constant.
+func (f *file) packageConst(name string) *types.Const {
+	f.analyze()
+
+	for ident, obj := range f.info.Defs {
+		if ident.Name != name {
+			continue
+		}
+		if c, isConst := obj.(*types.Const); isConst && c.Pkg() != nil && c.Parent() == c.Pkg().Scope() {
+			return c
+		}
+	}
+	return nil
+}
+
 /// funcSignature returns signature of a function. Can be used to verify converters - see unfinished code in analyze()
 //func (f *file) funcSignature(name string) (*types.Signature, error) {
 //	return nil, nil
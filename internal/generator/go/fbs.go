@@ -0,0 +1,269 @@
+/*
+ * ObjectBox Generator - a build time tool for ObjectBox
+ * Copyright (C) 2018-2024 ObjectBox Ltd. All rights reserved.
+ * https://objectbox.io
+ *
+ * This file is part of ObjectBox Generator.
+ *
+ * ObjectBox Generator is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ * ObjectBox Generator is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with ObjectBox Generator.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package gogenerator
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/model"
+)
+
+// fbsScalarTypes maps model property types to FlatBuffers schema types; unsigned variants are handled separately.
+var fbsScalarTypes = map[model.PropertyType]string{
+	model.PropertyTypeBool:         "bool",
+	model.PropertyTypeByte:         "byte",
+	model.PropertyTypeShort:        "short",
+	model.PropertyTypeInt:          "int",
+	model.PropertyTypeLong:         "long",
+	model.PropertyTypeFloat:        "float",
+	model.PropertyTypeDouble:       "double",
+	model.PropertyTypeString:       "string",
+	model.PropertyTypeDate:         "long",
+	model.PropertyTypeDateNano:     "long",
+	model.PropertyTypeRelation:     "ulong",
+	model.PropertyTypeByteVector:   "[ubyte]",
+	model.PropertyTypeFloatVector:  "[float]",
+	model.PropertyTypeStringVector: "[string]",
+}
+
+// fbsAnnotatedFlags are property flags fully represented by annotations (or types) in the generated schema.
+const fbsAnnotatedFlags = model.PropertyFlagId | model.PropertyFlagIdSelfAssignable | model.PropertyFlagUnique |
+	model.PropertyFlagIndexed | model.PropertyFlagIndexHash | model.PropertyFlagIndexHash64 |
+	model.PropertyFlagUnsigned | model.PropertyFlagIdCompanion | model.PropertyFlagUniqueOnConflictReplace
+
+// generateFbsSchema creates a FlatBuffers schema describing all entities of the given model.
+// Each field carries an explicit `id` attribute equal to its slot, so code generated by flatc reads/writes the same
+// layout as ObjectBox. Slots of removed properties are filled with deprecated (and transient) placeholders.
+// UIDs are kept in annotations, so generating from the schema with the same model JSON results in the same model.
+func generateFbsSchema(banner string, m *model.ModelInfo) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(banner + "\n")
+
+	for _, entity := range m.Entities {
+		b.WriteString("\n")
+		if err := writeFbsTable(&b, m, entity); err != nil {
+			return nil, fmt.Errorf("entity %s: %s", entity.Name, err)
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+func writeFbsTable(b *bytes.Buffer, m *model.ModelInfo, entity *model.Entity) error {
+	uid, err := entity.Id.GetUid()
+	if err != nil {
+		return err
+	}
+
+	var annotations = []string{"uid=" + strconv.FormatUint(uid, 10)}
+	if entity.Flags&model.EntityFlagSharedGlobalIds != 0 {
+		annotations = append(annotations, "sync(sharedGlobalIds)")
+	} else if entity.Flags&model.EntityFlagSyncEnabled != 0 {
+		annotations = append(annotations, "sync")
+	}
+	writeFbsDoc(b, "", entity.Comments, annotations)
+
+	for _, relation := range entity.Relations {
+		targetUid, err := relation.TargetId.GetUid()
+		if err != nil {
+			return fmt.Errorf("relation %s: %s", relation.Name, err)
+		}
+		target, err := m.FindEntityByUid(targetUid)
+		if err != nil {
+			return fmt.Errorf("relation %s: %s", relation.Name, err)
+		}
+		relUid, err := relation.Id.GetUid()
+		if err != nil {
+			return fmt.Errorf("relation %s: %s", relation.Name, err)
+		}
+		writeFbsDoc(b, "", nil, []string{fmt.Sprintf("relation(name=%s, to=%s, uid=%d)", relation.Name, target.Name, relUid)})
+	}
+
+	b.WriteString("table " + entity.Name + " {\n")
+
+	// order properties by slot, filling the gaps left by removed properties
+	var properties = make([]*model.Property, len(entity.Properties))
+	copy(properties, entity.Properties)
+	sort.Slice(properties, func(i, j int) bool {
+		return properties[i].FbSlot() < properties[j].FbSlot()
+	})
+
+	var fieldNames = make(map[string]string)
+	var slot = 0
+	for _, property := range properties {
+		for ; slot < property.FbSlot(); slot++ {
+			writeFbsDoc(b, "\t", nil, []string{"transient"})
+			fmt.Fprintf(b, "\t_unused%d : ubyte (id: %d, deprecated);\n", slot, slot)
+		}
+
+		fbsType, annotations, err := fbsField(property)
+		if err != nil {
+			return fmt.Errorf("property %s: %s", property.Name, err)
+		}
+
+		// flatc expects snake_case field names, the DB name is kept using the `name` annotation
+		var fieldName = fbsFieldName(property.Name)
+		if other, exists := fieldNames[fieldName]; exists {
+			return fmt.Errorf("properties %s and %s would have the same field name %s", other, property.Name, fieldName)
+		}
+		fieldNames[fieldName] = property.Name
+		if fieldName != property.Name {
+			annotations = append([]string{"name=" + property.Name}, annotations...)
+		}
+
+		writeFbsDoc(b, "\t", property.Comments, annotations)
+		fmt.Fprintf(b, "\t%s : %s (id: %d);\n", fieldName, fbsType, slot)
+		slot++
+	}
+
+	b.WriteString("}\n")
+	return nil
+}
+
+// fbsField returns the schema type and ObjectBox annotations of the given property
+func fbsField(property *model.Property) (string, []string, error) {
+	var fbsType = fbsScalarTypes[property.Type]
+	if len(fbsType) == 0 {
+		return "", nil, fmt.Errorf("type %s can't be represented in a FlatBuffers schema", model.PropertyTypeNames[property.Type])
+	}
+
+	var flags = property.Flags
+	var annotations []string
+
+	if property.IsIdProperty() {
+		fbsType = "ulong"
+		if flags&model.PropertyFlagIdSelfAssignable != 0 {
+			annotations = append(annotations, "id(assignable)")
+		} else {
+			annotations = append(annotations, "id")
+		}
+	} else if flags&model.PropertyFlagUnsigned != 0 && !strings.HasPrefix(fbsType, "[") {
+		fbsType = "u" + fbsType
+	}
+
+	switch property.Type {
+	case model.PropertyTypeDate:
+		annotations = append(annotations, "date")
+	case model.PropertyTypeDateNano:
+		annotations = append(annotations, "date-nano")
+	case model.PropertyTypeRelation:
+		annotations = append(annotations, "relation="+property.RelationTarget)
+		// the index is implied by the relation
+		flags = flags & ^(model.PropertyFlagIndexed | model.PropertyFlagIndexPartialSkipZero)
+	}
+
+	if flags&model.PropertyFlagIdCompanion != 0 {
+		annotations = append(annotations, "id-companion")
+	}
+
+	if flags&model.PropertyFlagUniqueOnConflictReplace != 0 {
+		annotations = append(annotations, "unique=replace")
+	} else if flags&model.PropertyFlagUnique != 0 {
+		annotations = append(annotations, "unique")
+	}
+
+	if property.HnswParams != nil {
+		annotations = append(annotations, "index=hnsw")
+		annotations = append(annotations, fbsHnswAnnotations(property.HnswParams)...)
+	} else if flags&model.PropertyFlagIndexHash != 0 {
+		annotations = append(annotations, "index=hash")
+	} else if flags&model.PropertyFlagIndexHash64 != 0 {
+		annotations = append(annotations, "index=hash64")
+	} else if flags&model.PropertyFlagIndexed != 0 {
+		annotations = append(annotations, "index=value")
+	}
+
+	if unsupported := flags & ^fbsAnnotatedFlags; unsupported != 0 {
+		return "", nil, fmt.Errorf("flags %d can't be represented in a FlatBuffers schema", unsupported)
+	}
+
+	uid, err := property.Id.GetUid()
+	if err != nil {
+		return "", nil, err
+	}
+	annotations = append(annotations, "uid="+strconv.FormatUint(uid, 10))
+
+	return fbsType, annotations, nil
+}
+
+func fbsHnswAnnotations(params *model.HnswParams) []string {
+	var result []string
+	if params.Dimensions != nil {
+		result = append(result, fmt.Sprintf("hnsw-dimensions=%d", *params.Dimensions))
+	}
+	if len(params.DistanceType) != 0 {
+		result = append(result, "hnsw-distance-type="+params.DistanceType)
+	}
+	if params.NeighborsPerNode != nil {
+		result = append(result, fmt.Sprintf("hnsw-neighbors-per-node=%d", *params.NeighborsPerNode))
+	}
+	if params.IndexingSearchCount != nil {
+		result = append(result, fmt.Sprintf("hnsw-indexing-search-count=%d", *params.IndexingSearchCount))
+	}
+	if params.ReparationBacklinkProbability != nil {
+		result = append(result, "hnsw-reparation-backlink-probability="+
+			strconv.FormatFloat(float64(*params.ReparationBacklinkProbability), 'g', -1, 32))
+	}
+	if params.VectorCacheHintSizeKb != nil {
+		result = append(result, fmt.Sprintf("hnsw-vector-cache-hint-size-kb=%d", *params.VectorCacheHintSizeKb))
+	}
+	if params.Flags != nil && *params.Flags != model.HnswFlagNone {
+		var names []string
+		for flag, name := range model.HnswFlagNames {
+			if flag != model.HnswFlagNone && *params.Flags&flag != 0 {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		result = append(result, "hnsw-flags="+strings.Join(names, "|"))
+	}
+	return result
+}
+
+// fbsFieldName converts a property name to snake_case, e.g. "PublishedAt" => "published_at", "ISBN" => "isbn"
+func fbsFieldName(name string) string {
+	var runes = []rune(name)
+	var result []rune
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && runes[i-1] != '_' && (!unicode.IsUpper(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				result = append(result, '_')
+			}
+			r = unicode.ToLower(r)
+		}
+		result = append(result, r)
+	}
+	return string(result)
+}
+
+func writeFbsDoc(b *bytes.Buffer, indent string, comments []string, annotations []string) {
+	for _, comment := range comments {
+		b.WriteString(indent + "/// " + comment + "\n")
+	}
+	if len(annotations) > 0 {
+		b.WriteString(indent + "/// objectbox:" + strings.Join(annotations, ", ") + "\n")
+	}
+}
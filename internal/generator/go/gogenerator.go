@@ -24,8 +24,14 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/objectbox/objectbox-generator/v4/internal/generator"
@@ -35,16 +41,70 @@ import (
 
 type GoGenerator struct {
 	binding *astReader
+
 	ByValue bool
+	Clone   bool // generate a Clone() method for each entity
+	Equal   bool // generate an Equal() method for each entity
+
+	// Stringer enables generating a String() method for each entity, printing the stored properties for debugging.
+	Stringer bool
+
+	// Observers enables generating a typed Subscribe() method on boxes, wrapping objectbox-go data observers.
+	// Opt-in because it requires a version of objectbox-go providing ObjectBox.Subscribe().
+	Observers bool
+
+	// LazyRelations sets the default load policy for to-many relations, overridable by `lazy`/`eager` annotations.
+	// Eager loading (the default) reads all related objects on Get(), which is convenient but can be expensive for
+	// large relations. Lazy loading leaves the slice nil until the generated Fetch*() method is called.
+	// To-one relations are always loaded eagerly.
+	LazyRelations bool
+
+	// FbsOut is an optional path of a FlatBuffers schema (.fbs) to write, describing all entities in the model.
+	// The schema can be used with flatc or as an input for objectbox-generator in other languages.
+	FbsOut string
+
+	// Package overrides the package name of the generated files, which defaults to the package of the source file.
+	// Note: the generated code refers to the entity types without a qualifier, i.e. they must be accessible as such.
+	Package string
+
+	// BuildTag is an optional build constraint expression, e.g. "objectbox", written as a "//go:build" line to all
+	// generated files so that they're only included in builds satisfying it.
+	BuildTag string
+}
+
+// packageName returns the package clause name for the generated files
+func (goGen *GoGenerator) packageName() string {
+	if len(goGen.Package) > 0 {
+		return goGen.Package
+	}
+	return goGen.binding.Package.Name()
+}
+
+// validateBuildTag checks the given build constraint fits on the single "//go:build" line
+func validateBuildTag(expr string) error {
+	if len(strings.TrimSpace(expr)) == 0 || strings.ContainsAny(expr, "\r\n") || strings.HasPrefix(expr, "//") {
+		return fmt.Errorf("invalid build tag '%s', expecting a single-line build constraint expression, e.g. 'objectbox'", expr)
+	}
+	return nil
 }
 
+// validatePackageName checks the given name can be used in a package clause
+func validatePackageName(name string) error {
+	if name == "_" || token.Lookup(name).IsKeyword() || !packageNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid package name '%s', expecting a Go identifier", name)
+	}
+	return nil
+}
+
+var packageNameRegexp = regexp.MustCompile(`^[\p{L}_][\p{L}\p{Nd}_]*$`)
+
 // BindingFiles returns names of binding files for the given entity file.
 func (gen *GoGenerator) BindingFiles(forFile string, options generator.Options) []string {
 	if len(options.OutPath) > 0 {
 		forFile = filepath.Join(options.OutPath, filepath.Base(forFile))
 	}
 	var extension = filepath.Ext(forFile)
-	return []string{forFile[0:len(forFile)-len(extension)] + ".obx" + extension}
+	return []string{forFile[0:len(forFile)-len(extension)] + options.BindingSuffix() + extension}
 }
 
 // ModelFile returns the model GO file for the given JSON info file path
@@ -56,9 +116,9 @@ func (gen *GoGenerator) ModelFile(forFile string, options generator.Options) str
 	return forFile[0:len(forFile)-len(extension)] + ".go"
 }
 
-func (GoGenerator) IsGeneratedFile(file string) bool {
+func (GoGenerator) IsGeneratedFile(file string, options generator.Options) bool {
 	var name = filepath.Base(file)
-	return name == "objectbox-model.go" || strings.HasSuffix(name, ".obx.go")
+	return name == "objectbox-model.go" || strings.HasSuffix(name, options.BindingSuffix()+".go")
 }
 
 func (GoGenerator) IsSourceFile(file string) bool {
@@ -67,23 +127,67 @@ func (GoGenerator) IsSourceFile(file string) bool {
 	return strings.HasSuffix(file, ".go")
 }
 
+// SupportedTypes lists Go basic types (and time.Time) that can be stored without a custom converter.
+func (GoGenerator) SupportedTypes() []generator.TypeMapping {
+	var result []generator.TypeMapping
+	for _, t := range goBasicTypes {
+		result = append(result, generator.TypeMapping{SourceType: t.goType, PropertyType: t.obxType, Flags: t.flags})
+	}
+	// handled by a built-in converter, see Entity.addFields()
+	result = append(result, generator.TypeMapping{SourceType: "time.Time", PropertyType: model.PropertyTypeDate})
+	return result
+}
+
 func (goGen *GoGenerator) ParseSource(sourceFile string) (*model.ModelInfo, error) {
+	binding, err := goGen.parseSource(sourceFile)
+	if err != nil {
+		return nil, err
+	}
+	goGen.binding = binding
+	return binding.model, nil
+}
+
+// ParseSourceConcurrently implements generator.ConcurrentSourceParser
+func (goGen *GoGenerator) ParseSourceConcurrently(sourceFile string) (*model.ModelInfo, func(), error) {
+	binding, err := goGen.parseSource(sourceFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	return binding.model, func() { goGen.binding = binding }, nil
+}
+
+// parseSource reads the given file without changing the generator state
+func (goGen *GoGenerator) parseSource(sourceFile string) (*astReader, error) {
 	var f *file
 	var err error
 
+	if len(goGen.Package) > 0 {
+		if err = validatePackageName(goGen.Package); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(goGen.BuildTag) > 0 {
+		if err = validateBuildTag(goGen.BuildTag); err != nil {
+			return nil, err
+		}
+	}
+
 	if f, err = parseFile(sourceFile); err != nil {
 		return nil, fmt.Errorf("can't parse file %s: %s", sourceFile, err)
 	}
 
-	if goGen.binding, err = NewBinding(); err != nil {
+	binding, err := NewBinding()
+	if err != nil {
 		return nil, fmt.Errorf("can't init Go AST reader: %s", err)
 	}
+	binding.lazyRelations = goGen.LazyRelations
 
-	if err = goGen.binding.CreateFromAst(f); err != nil {
+	if err = binding.CreateFromAst(f); err != nil {
 		return nil, fmt.Errorf("can't prepare bindings for %s: %s", sourceFile, err)
 	}
 
-	return goGen.binding.model, nil
+	return binding, nil
 }
 
 func (goGen *GoGenerator) WriteBindingFiles(sourceFile string, options generator.Options, mergedModel *model.ModelInfo) error {
@@ -95,6 +199,10 @@ func (goGen *GoGenerator) WriteBindingFiles(sourceFile string, options generator
 
 	var err, err2 error
 
+	if err = goGen.checkSourceDir(sourceFile, options, mergedModel); err != nil {
+		return err
+	}
+
 	var bindingSource []byte
 	if bindingSource, err = goGen.generateBindingFile(options, mergedModel); err != nil {
 		return fmt.Errorf("can't generate binding file %s: %s", sourceFile, err)
@@ -104,14 +212,14 @@ func (goGen *GoGenerator) WriteBindingFiles(sourceFile string, options generator
 	if len(bindingFiles) != 1 {
 		panic("internal error - someone changed GoGenerator::BindingFiles()?")
 	}
-	if formattedSource, err := format.Source(bindingSource); err != nil {
+	if formattedSource, err := formatSource(bindingSource); err != nil {
 		// we just store error but still write the file so that we can check it manually
 		err2 = fmt.Errorf("failed to format generated binding file %s: %s", bindingFiles[0], err)
 	} else {
 		bindingSource = formattedSource
 	}
 
-	if err = generator.WriteFile(bindingFiles[0], bindingSource, sourceFile); err != nil {
+	if err = options.WriteOutput(bindingFiles[0], bindingSource, sourceFile); err != nil {
 		return fmt.Errorf("can't write binding file %s: %s", sourceFile, err)
 	} else if err2 != nil {
 		// now when the binding has been written (for debugging purposes), we can return the error
@@ -126,12 +234,19 @@ func (goGen *GoGenerator) generateBindingFile(options generator.Options, m *mode
 	writer := bufio.NewWriter(&b)
 
 	var tplArguments = struct {
+		Banner           string
+		BuildTag         string
+		Package          string
 		Model            *model.ModelInfo
 		Binding          *astReader
 		ByValue          bool
+		Clone            bool
+		Equal            bool
+		Observers        bool
+		Stringer         bool
 		GeneratorVersion int
 		Options          generator.Options
-	}{m, goGen.binding, goGen.ByValue, generator.VersionId, options}
+	}{options.Banner(), goGen.BuildTag, goGen.packageName(), m, goGen.binding, goGen.ByValue, goGen.Clone, goGen.Equal, goGen.Observers, goGen.Stringer, generator.VersionId, options}
 
 	if err = templates.BindingTemplate.Execute(writer, tplArguments); err != nil {
 		return nil, fmt.Errorf("template execution failed: %s", err)
@@ -144,42 +259,138 @@ func (goGen *GoGenerator) generateBindingFile(options generator.Options, m *mode
 	return b.Bytes(), nil
 }
 
+// formatSource formats the generated code and removes unused imports, e.g. added conditionally by the templates
+func formatSource(source []byte) ([]byte, error) {
+	source, err := format.Source(source)
+	if err != nil {
+		return nil, err
+	}
+
+	var fset = token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", source, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var used = make(map[string]bool)
+	ast.Inspect(f, func(node ast.Node) bool {
+		if selector, isSelector := node.(*ast.SelectorExpr); isSelector {
+			if ident, isIdent := selector.X.(*ast.Ident); isIdent {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	var unusedLines = make(map[int]bool)
+	for _, spec := range f.Imports {
+		if name := importName(spec); len(name) > 0 && !used[name] {
+			unusedLines[fset.Position(spec.Pos()).Line] = true
+		}
+	}
+
+	if len(unusedLines) == 0 {
+		return source, nil
+	}
+
+	// the template writes one import per line; drop the whole lines and let gofmt fix up the rest
+	var pruned bytes.Buffer
+	for i, line := range bytes.SplitAfter(source, []byte("\n")) {
+		if !unusedLines[i+1] {
+			pruned.Write(line)
+		}
+	}
+	return format.Source(pruned.Bytes())
+}
+
+// importName returns the name the import is referred to by, or an empty string if it can't be determined from the
+// import path alone (e.g. "github.com/google/flatbuffers/go") or the import isn't referred to by name (blank & dot).
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		if spec.Name.Name == "_" || spec.Name.Name == "." {
+			return ""
+		}
+		return spec.Name.Name
+	}
+
+	importPath, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return ""
+	}
+	var name = path.Base(importPath)
+	if token.Lookup(name).IsKeyword() || !packageNameRegexp.MatchString(name) {
+		return ""
+	}
+	return name
+}
+
+// checkSourceDir verifies all entities come from a single package; the generated model file refers to the entity bindings
+// without a package qualifier so it can't register entities of other packages, e.g. when generating recursively.
+// Only entities merged in the current run are considered, see model.Entity.DeclaredIn.
+func (goGen *GoGenerator) checkSourceDir(sourceFile string, options generator.Options, mergedModel *model.ModelInfo) error {
+	if len(goGen.binding.model.Entities) == 0 {
+		return nil
+	}
+
+	var dir = filepath.Dir(sourceFile)
+	for _, entity := range mergedModel.Entities {
+		if len(entity.DeclaredIn) == 0 {
+			continue
+		}
+		if previous := filepath.Dir(entity.DeclaredIn); previous != dir {
+			return fmt.Errorf("the model info file %s can't be shared by entities in multiple Go packages (%s and %s); "+
+				"generate each package separately, each with its own model info file", options.ModelInfoFile, previous, dir)
+		}
+	}
+	return nil
+}
+
 func (goGen *GoGenerator) WriteModelBindingFile(options generator.Options, modelInfo *model.ModelInfo) error {
 	var err, err2 error
 
 	var modelFile = goGen.ModelFile(options.ModelInfoFile, options)
 	var modelSource []byte
 
-	if modelSource, err = goGen.generateModelFile(modelInfo); err != nil {
+	if modelSource, err = goGen.generateModelFile(options, modelInfo); err != nil {
 		return fmt.Errorf("can't generate model file %s: %s", modelFile, err)
 	}
 
-	if formattedSource, err := format.Source(modelSource); err != nil {
+	if formattedSource, err := formatSource(modelSource); err != nil {
 		// we just store error but still writ the file so that we can check it manually
 		err2 = fmt.Errorf("failed to format generated model file %s: %s", modelFile, err)
 	} else {
 		modelSource = formattedSource
 	}
 
-	if err = generator.WriteFile(modelFile, modelSource, options.ModelInfoFile); err != nil {
+	if err = options.WriteOutput(modelFile, modelSource, options.ModelInfoFile); err != nil {
 		return fmt.Errorf("can't write model file %s: %s", modelFile, err)
 	} else if err2 != nil {
 		// now when the model has been written (for debugging purposes), we can return the error
 		return err2
 	}
 
+	if len(goGen.FbsOut) > 0 {
+		if schema, err := generateFbsSchema(options.Banner(), modelInfo); err != nil {
+			return fmt.Errorf("can't generate FlatBuffers schema %s: %s", goGen.FbsOut, err)
+		} else if err = options.WriteOutput(goGen.FbsOut, schema, options.ModelInfoFile); err != nil {
+			return fmt.Errorf("can't write FlatBuffers schema %s: %s", goGen.FbsOut, err)
+		}
+	}
+
 	return nil
 }
 
-func (goGen *GoGenerator) generateModelFile(m *model.ModelInfo) (data []byte, err error) {
+func (goGen *GoGenerator) generateModelFile(options generator.Options, m *model.ModelInfo) (data []byte, err error) {
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
 
 	var tplArguments = struct {
+		Banner           string
+		BuildTag         string
 		Package          string
 		Model            *model.ModelInfo
 		GeneratorVersion int
-	}{goGen.binding.Package.Name(), m, generator.VersionId}
+	}{options.Banner(), goGen.BuildTag, goGen.packageName(), m, generator.VersionId}
 
 	if err = templates.ModelTemplate.Execute(writer, tplArguments); err != nil {
 		return nil, fmt.Errorf("template execution failed: %s", err)
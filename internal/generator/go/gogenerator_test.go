@@ -0,0 +1,67 @@
+/*
+ * ObjectBox Generator - a build time tool for ObjectBox
+ * Copyright (C) 2024 ObjectBox Ltd. All rights reserved.
+ * https://objectbox.io
+ *
+ * This file is part of ObjectBox Generator.
+ *
+ * ObjectBox Generator is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ * ObjectBox Generator is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with ObjectBox Generator.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package gogenerator
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/objectbox/objectbox-generator/v4/test/assert"
+)
+
+func TestFormatSource(t *testing.T) {
+	// odd whitespace as produced by template conditionals, an unused conditional import and imports that must be kept
+	var source = `package object
+import (
+	"errors"
+	"github.com/google/flatbuffers/go"
+		"strconv"
+	xfmt "fmt"
+	_ "embed"
+
+)
+
+
+func   check(fbb *flatbuffers.Builder)error{
+	if fbb==nil {   return errors.New(xfmt.Sprint("no builder")) }
+
+
+	return nil
+}
+`
+	formatted, err := formatSource([]byte(source))
+	assert.NoErr(t, err)
+	assert.True(t, !strings.Contains(string(formatted), `"strconv"`))
+	assert.True(t, strings.Contains(string(formatted), `"errors"`))
+	assert.True(t, strings.Contains(string(formatted), `"github.com/google/flatbuffers/go"`))
+	assert.True(t, strings.Contains(string(formatted), `xfmt "fmt"`))
+	assert.True(t, strings.Contains(string(formatted), `_ "embed"`))
+
+	// gofmt-stable, i.e. formatting the output again doesn't change it
+	reformatted, err := format.Source(formatted)
+	assert.NoErr(t, err)
+	assert.Eq(t, string(formatted), string(reformatted))
+
+	again, err := formatSource(formatted)
+	assert.NoErr(t, err)
+	assert.Eq(t, string(formatted), string(again))
+}
@@ -26,7 +26,10 @@ import (
 
 // BindingTemplate is used to generated the binding code
 var BindingTemplate = template.Must(template.New("binding").Funcs(funcMap).Parse(
-	`// Code generated by ObjectBox; DO NOT EDIT. 
+	`{{.Banner}}
+{{with .BuildTag}}//go:build {{.}}
+
+{{end -}}
 // Learn more about defining entities and generating this file - visit https://golang.objectbox.io/entity-annotations
 
 {{define "property-getter-with-converter-val"}}{{/* used in Load*/}}
@@ -34,9 +37,17 @@ var BindingTemplate = template.Must(template.New("binding").Funcs(funcMap).Parse
 	{{- else}} {{template "property-getter" .}}
 	{{- end}}
 {{- end -}}
+{{define "string-verb"}}{{/* fmt verb used in String() */}}
+	{{- if or (eq .GoType "string") (eq .GoType "[]string")}}%q
+	{{- else if eq .GoType "[]byte"}}%x
+	{{- else}}%v
+	{{- end}}
+{{- end -}}
 {{define "property-getter"}}{{/* used in Load*/}}
 	{{- if .CastOnWrite}}{{.CastOnWrite}}({{end}}
 		{{- if eq .FbType "UOffsetT"}} fbutils.Get{{.ObTypeString}}{{if .GoField.IsPointer}}Ptr{{end}}Slot(table, {{.ModelProperty.FbvTableOffset}})
+		{{- else if .DefaultValue}} {{if ne .GoType .FbGoType}}{{.GoType}}({{end -}}
+			table.Get{{.FbType}}Slot({{.ModelProperty.FbvTableOffset}}, {{.DefaultValue}}){{if ne .GoType .FbGoType}}){{end}}
     	{{- else}} fbutils.Get{{.GoType | StringTitle}}{{if .GoField.IsPointer}}Ptr{{end}}Slot(table, {{.ModelProperty.FbvTableOffset}})
     	{{- end}}
 	{{- if .CastOnWrite}}){{end}}
@@ -49,13 +60,18 @@ var BindingTemplate = template.Must(template.New("binding").Funcs(funcMap).Parse
 {{- end -}}
 
 
-package {{.Binding.Package.Name}}
+package {{.Package}}
 
 import (
+	"context"
 	"errors"
 	"github.com/google/flatbuffers/go"
 	"github.com/objectbox/objectbox-go/objectbox"
 	"github.com/objectbox/objectbox-go/objectbox/fbutils"
+	"sync"
+	{{if and .Stringer (ne (index .Binding.Imports "fmt") "fmt") -}}
+	"fmt"
+	{{end -}}
 	{{range $alias, $path := .Binding.Imports -}}
 		{{if not (eq $alias $path)}}{{$alias}}{{end}} "{{$path}}"
 	{{end}}
@@ -63,6 +79,9 @@ import (
 
 {{range $entity := .Model.EntitiesWithMeta -}}
 {{$entityNameCamel := $entity.Name | StringCamel -}}
+// {{$entity.Name}}EntityUid is the UID of the {{$entity.Name}} entity in the model (objectbox-model.json)
+const {{$entity.Name}}EntityUid uint64 = {{$entity.Id.GetUid}}
+
 type {{$entityNameCamel}}_EntityInfo struct {
 	objectbox.Entity
 	Uid uint64
@@ -72,7 +91,7 @@ var {{$entity.Name}}Binding = {{$entityNameCamel}}_EntityInfo {
 	Entity: objectbox.Entity{
 		Id: {{$entity.Id.GetId}},
 	}, 
-	Uid: {{$entity.Id.GetUid}},
+	Uid: {{$entity.Name}}EntityUid,
 }
 
 // {{$entity.Name}}_ contains type-based Property helpers to facilitate some common operations such as Queries. 
@@ -107,6 +126,26 @@ var {{$entity.Name}}_ = struct {
     {{end -}}
 }
 
+// PropertyByName returns a property given its name in the database or the path of the Go field, e.g. for dynamic queries.
+// Returns nil if there's no such property. Names in the database take precedence.
+func ({{$entityNameCamel}}_EntityInfo) PropertyByName(name string) *objectbox.BaseProperty {
+	switch name {
+	{{- range $property := $entity.Properties}}
+	case "{{$property.Name}}":
+		return {{$entity.Name}}_.{{$property.Meta.Name}}.{{if $property.RelationTarget}}Property{{else}}BaseProperty{{end}}
+	{{- end}}
+	}
+	{{- with $entity.Meta.PropertiesRenamedInDb}}
+	switch name {
+	{{- range $property := .}}
+	case "{{$property.Path}}":
+		return {{$entity.Name}}_.{{$property.Name}}.{{if $property.ModelProperty.RelationTarget}}Property{{else}}BaseProperty{{end}}
+	{{- end}}
+	}
+	{{- end}}
+	return nil
+}
+
 // GeneratorVersion is called by ObjectBox to verify the compatibility of the generator used to generate this code	
 func ({{$entityNameCamel}}_EntityInfo) GeneratorVersion() int {
 	return {{$.GeneratorVersion}}
@@ -114,7 +153,7 @@ func ({{$entityNameCamel}}_EntityInfo) GeneratorVersion() int {
 
 // AddToModel is called by ObjectBox during model build
 func ({{$entityNameCamel}}_EntityInfo) AddToModel(model *objectbox.Model) {
-    model.Entity("{{$entity.Name}}", {{$entity.Id.GetId}}, {{$entity.Id.GetUid}})
+    model.Entity("{{$entity.Meta.DbName}}", {{$entity.Id.GetId}}, {{$entity.Id.GetUid}})
     {{with $entity.Flags -}}
 		model.EntityFlags({{.}})
 	{{end -}}
@@ -125,6 +164,22 @@ func ({{$entityNameCamel}}_EntityInfo) AddToModel(model *objectbox.Model) {
 	{{end -}}
 	{{if $property.RelationTarget}}model.PropertyRelation("{{$property.RelationTarget}}", {{$property.IndexId.GetId}}, {{$property.IndexId.GetUid}})
 	{{else if $property.IndexId}}model.PropertyIndex({{$property.IndexId.GetId}}, {{$property.IndexId.GetUid}})
+	{{with $property.HnswParams -}}
+	{{with .Dimensions}}model.PropertyIndexHnswDimensions({{.}})
+	{{end -}}
+	{{with .DistanceType}}model.PropertyIndexHnswDistanceType(objectbox.VectorDistanceType{{.}})
+	{{end -}}
+	{{with .NeighborsPerNode}}model.PropertyIndexHnswNeighborsPerNode({{.}})
+	{{end -}}
+	{{with .IndexingSearchCount}}model.PropertyIndexHnswIndexingSearchCount({{.}})
+	{{end -}}
+	{{with .ReparationBacklinkProbability}}model.PropertyIndexHnswReparationBacklinkProbability({{.}})
+	{{end -}}
+	{{with .VectorCacheHintSizeKb}}model.PropertyIndexHnswVectorCacheHintSizeKb({{.}})
+	{{end -}}
+	{{with .Flags}}model.PropertyIndexHnswFlags({{.}})
+	{{end -}}
+	{{end -}}
     {{end -}}
     {{end -}}
     model.EntityLastPropertyId({{$entity.LastPropertyId.GetId}}, {{$entity.LastPropertyId.GetUid}})
@@ -216,7 +271,14 @@ func ({{$entityNameCamel}}_EntityInfo) Flatten(object interface{}, fbb *flatbuff
 	}
 	{{end}}{{end}}
 
-    {{- range $property := $entity.Properties}}{{if eq $property.Meta.FbType "UOffsetT"}}
+    {{- range $property := $entity.Properties}}{{if $property.Meta.OrderOf}}
+	var offset{{$property.Meta.Name}} flatbuffers.UOffsetT
+	if order, err := {{$entity.Name}}Binding.flatten{{$property.Meta.Name}}(obj.{{$property.Meta.OrderOf.Path}}); err != nil {
+		return err
+	} else if order != nil {
+		offset{{$property.Meta.Name}} = fbutils.CreateByteVectorOffset(fbb, order)
+	}
+	{{- else if eq $property.Meta.FbType "UOffsetT"}}
 	{{if $property.Meta.GoField.IsPointer}}
 	var offset{{$property.Meta.Name}} flatbuffers.UOffsetT
 	if obj.{{$property.Meta.Path}} != nil {
@@ -265,7 +327,12 @@ func ({{$entityNameCamel}}_EntityInfo) Flatten(object interface{}, fbb *flatbuff
 					{{- template "property-access" . -}})
 					{{- if or (eq .GoType "int") (eq .GoType "uint")}} ) {{end}}
 				{{- end}}{{end}}
-			{{- else}}{{template "fields-setter" $field}}{{end -}}
+			{{- else}}
+				{{- with $field.OrderProperty}}
+				fbutils.SetUOffsetTSlot(fbb, {{.ModelProperty.FbSlot}}, offset{{.Name}})
+				{{- end}}
+				{{- template "fields-setter" $field}}
+			{{- end -}}
 			{{- if $field.IsPointer -}} } {{- end -}}
 		{{- end -}}
 	{{end}}
@@ -301,7 +368,9 @@ func ({{$entityNameCamel}}_EntityInfo) Load(ob *objectbox.ObjectBox, bytes []byt
 			var rel{{$field.Name}} {{$field.Type}} 
 			if rIds, err := BoxFor{{$field.Entity.Name}}(ob).RelationIds({{.Entity.Name}}_.{{$field.Name}}, prop{{.Entity.ModelEntity.IdProperty.Name}}); err != nil {
 				return nil, err
-			} else if rSlice, err := BoxFor{{$field.StandaloneRelation.Target.Name}}(ob).GetManyExisting(rIds...); err != nil {
+			} else if rSlice, err := BoxFor{{$field.StandaloneRelation.Target.Name}}(ob).GetManyExisting(
+				{{- with $field.OrderProperty}}{{$field.Entity.Name}}Binding.sort{{.Name}}({{template "property-getter" .}}, rIds)
+				{{- else}}rIds{{end}}...); err != nil {
 				return nil, err
 			} else {
 				rel{{$field.Name}} = rSlice
@@ -363,6 +432,249 @@ func ({{$entityNameCamel}}_EntityInfo) AppendToSlice(slice interface{}, object i
 	return append(slice.([]{{if not $.ByValue}}*{{end}}{{$entity.Name}}), {{if $.ByValue}}*{{end}}object.(*{{$entity.Name}}))
 }
 
+{{range $property := $entity.Properties}}{{if $property.Meta.FloatFormat -}}
+// {{$property.Meta.Converter}}ToDatabaseValue is a converter storing {{$entity.Name}}.{{$property.Meta.Path}} as a string using the "{{$property.Meta.FloatFormat}}" format
+func {{$property.Meta.Converter}}ToDatabaseValue(value {{$property.Meta.FloatType}}) (string, error) {
+	return fmt.Sprintf("{{$property.Meta.FloatFormat}}", value), nil
+}
+
+// {{$property.Meta.Converter}}ToEntityProperty is a converter reading {{$entity.Name}}.{{$property.Meta.Path}} stored as a string
+func {{$property.Meta.Converter}}ToEntityProperty(value string) ({{$property.Meta.FloatType}}, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+	result, err := strconv.ParseFloat(value, {{$property.Meta.FloatBits}})
+	return {{$property.Meta.FloatType}}(result), err
+}
+
+{{else if $property.Meta.JsonType -}}
+// {{$property.Meta.Converter}}ToDatabaseValue is a converter storing {{$entity.Name}}.{{$property.Meta.Path}} serialized as JSON
+func {{$property.Meta.Converter}}ToDatabaseValue(value {{$property.Meta.JsonType}}) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+// {{$property.Meta.Converter}}ToEntityProperty is a converter reading {{$entity.Name}}.{{$property.Meta.Path}} stored as JSON
+func {{$property.Meta.Converter}}ToEntityProperty(value []byte) ({{$property.Meta.JsonType}}, error) {
+	var result {{$property.Meta.JsonType}}
+	if len(value) == 0 {
+		return result, nil
+	}
+	err := json.Unmarshal(value, &result)
+	return result, err
+}
+
+{{end}}{{end -}}
+{{range $property := $entity.Properties}}{{with $property.Meta.OrderOf -}}
+// flatten{{$property.Meta.Name}} stores IDs of the objects related by {{$entity.Name}}.{{.Path}} in the order of the slice
+func ({{$entityNameCamel}}_EntityInfo) flatten{{$property.Meta.Name}}(rel {{.Type}}) ([]byte, error) {
+	if rel == nil {
+		return nil, nil
+	}
+	var order = make([]byte, 8*len(rel))
+	for i := range rel {
+		if rId, err := {{.StandaloneRelation.Target.Name}}Binding.GetId({{if not .HasPointerElements}}&{{end}}rel[i]); err != nil {
+			return nil, err
+		} else {
+			binary.LittleEndian.PutUint64(order[8*i:], rId)
+		}
+	}
+	return order, nil
+}
+
+// sort{{$property.Meta.Name}} sorts IDs of the objects related by {{$entity.Name}}.{{.Path}} to match the stored order.
+// IDs missing in the stored order, e.g. added using Box.RelationPut(), are placed at the end.
+func ({{$entityNameCamel}}_EntityInfo) sort{{$property.Meta.Name}}(order []byte, rIds []uint64) []uint64 {
+	var positions = make(map[uint64]int, len(order)/8)
+	for i := 0; i+8 <= len(order); i += 8 {
+		positions[binary.LittleEndian.Uint64(order[i:])] = i / 8
+	}
+	sort.SliceStable(rIds, func(a, b int) bool {
+		posA, okA := positions[rIds[a]]
+		posB, okB := positions[rIds[b]]
+		if okA && okB {
+			return posA < posB
+		}
+		return okA
+	})
+	return rIds
+}
+
+{{end}}{{end -}}
+{{if $.Clone -}}
+// Clone returns a deep copy of the object: slices and pointers to values are copied so they don't alias the original.
+// Related objects aren't copied - the clone references the same related objects as the original.
+func (obj *{{$entity.Name}}) Clone() *{{$entity.Name}} {
+	if obj == nil {
+		return nil
+	}
+	var clone = *obj
+	{{- block "clone-fields" $entity}}
+	{{- range $field := .Meta.Fields}}
+		{{- if $field.StandaloneRelation}}
+			if obj.{{$field.Path}} != nil {
+				clone.{{$field.Path}} = append(obj.{{$field.Path}}[:0:0], obj.{{$field.Path}}...)
+			}
+		{{- else if $field.Property}}
+			{{- if $field.Property.Converter}}{{/* custom types are copied as they are */}}
+			{{- else if and (not $field.Property.IsBasicType) $field.Property.ModelProperty.RelationTarget}}{{/* related object is shared */}}
+			{{- else if $field.IsPointer}}
+			if obj.{{$field.Path}} != nil {
+				var value = *obj.{{$field.Path}}
+				clone.{{$field.Path}} = &value
+			}
+			{{- else if $field.Property.IsVector}}
+			if obj.{{$field.Path}} != nil {
+				clone.{{$field.Path}} = append(obj.{{$field.Path}}[:0:0], obj.{{$field.Path}}...)
+			}
+			{{- end}}
+		{{- else if $field.IsPointer}}{{/* embedded struct pointer */}}
+			if obj.{{$field.Path}} != nil {
+				var value = *obj.{{$field.Path}}
+				clone.{{$field.Path}} = &value
+				{{- template "clone-fields" $field}}
+			}
+		{{- else}}{{/* embedded struct value */}}{{template "clone-fields" $field}}
+		{{- end}}
+	{{- end}}{{end}}
+	return &clone
+}
+
+{{end -}}
+{{if $.Equal -}}
+// Equal compares all stored properties of the objects, including slices element by element.
+// Related objects are compared by their IDs, not by their contents. Nil objects are only equal to each other.
+func (obj *{{$entity.Name}}) Equal(other *{{$entity.Name}}) bool {
+	if obj == nil || other == nil {
+		return obj == other
+	}
+	{{- block "equal-fields" $entity}}
+	{{- range $field := .Meta.Fields}}
+		{{- if $field.StandaloneRelation}}
+	if len(obj.{{$field.Path}}) != len(other.{{$field.Path}}) {
+		return false
+	}
+	for i := range obj.{{$field.Path}} {
+		{{- if $field.HasPointerElements}}
+		if (obj.{{$field.Path}}[i] == nil) != (other.{{$field.Path}}[i] == nil) {
+			return false
+		} else if obj.{{$field.Path}}[i] == nil {
+			continue
+		}
+		{{- end}}
+		a, errA := {{$field.StandaloneRelation.Target.Name}}Binding.GetId({{if not $field.HasPointerElements}}&{{end}}obj.{{$field.Path}}[i])
+		b, errB := {{$field.StandaloneRelation.Target.Name}}Binding.GetId({{if not $field.HasPointerElements}}&{{end}}other.{{$field.Path}}[i])
+		if errA != nil || errB != nil || a != b {
+			return false
+		}
+	}
+		{{- else if $field.Property}}
+			{{- if and (not $field.Property.IsBasicType) $field.Property.ModelProperty.RelationTarget}}
+				{{- if $field.IsPointer}}
+	if (obj.{{$field.Path}} == nil) != (other.{{$field.Path}} == nil) {
+		return false
+	} else if obj.{{$field.Path}} != nil {
+				{{- else}}
+	{
+				{{- end}}
+		a, errA := {{$field.Property.ModelProperty.RelationTarget}}Binding.GetId({{if not $field.IsPointer}}&{{end}}obj.{{$field.Path}})
+		b, errB := {{$field.Property.ModelProperty.RelationTarget}}Binding.GetId({{if not $field.IsPointer}}&{{end}}other.{{$field.Path}})
+		if errA != nil || errB != nil || a != b {
+			return false
+		}
+	}
+			{{- else if or $field.Property.Converter $field.IsPointer $field.Property.IsVector}}
+	{
+				{{- if $field.Property.Converter}}{{/* compare the values as stored in the database */}}
+		a, errA := {{$field.Property.Converter}}ToDatabaseValue(obj.{{$field.Path}})
+		b, errB := {{$field.Property.Converter}}ToDatabaseValue(other.{{$field.Path}})
+		if errA != nil || errB != nil {
+			return false
+		}
+				{{- else}}
+		a, b := obj.{{$field.Path}}, other.{{$field.Path}}
+				{{- end}}
+				{{- if $field.IsPointer}}
+		if (a == nil) != (b == nil) || (a != nil && *a != *b) {
+			return false
+		}
+				{{- else if $field.Property.IsVector}}
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+				{{- else}}
+		if a != b {
+			return false
+		}
+				{{- end}}
+	}
+			{{- else}}
+	if obj.{{$field.Path}} != other.{{$field.Path}} {
+		return false
+	}
+			{{- end}}
+		{{- else if $field.IsPointer}}{{/* embedded struct pointer */}}
+	if (obj.{{$field.Path}} == nil) != (other.{{$field.Path}} == nil) {
+		return false
+	} else if obj.{{$field.Path}} != nil {
+		{{- template "equal-fields" $field}}
+	}
+		{{- else}}{{/* embedded struct value */}}{{template "equal-fields" $field}}
+		{{- end}}
+	{{- end}}{{end}}
+	return true
+}
+
+{{end -}}
+{{if $.Stringer -}}
+// String returns a human-readable representation of the object's stored properties, e.g. for logging and debugging.
+// Strings are quoted, byte slices printed as hex and related objects represented by their IDs.
+func (obj *{{$entity.Name}}) String() string {
+	if obj == nil {
+		return "<nil>"
+	}
+	var s string
+	{{- block "string-fields" $entity}}
+	{{- range $field := .Meta.Fields}}
+		{{- if $field.StandaloneRelation}}{{/* not a property */}}
+		{{- else if $field.Property}}
+			{{- if and (not $field.Property.IsBasicType) $field.Property.ModelProperty.RelationTarget}}
+				{{- if $field.IsPointer}}
+	if obj.{{$field.Path}} == nil {
+		s += ", {{$field.Path}}: nil"
+	} else {
+				{{- else}}
+	{
+				{{- end}}
+		id, _ := {{$field.Property.ModelProperty.RelationTarget}}Binding.GetId({{if not $field.IsPointer}}&{{end}}obj.{{$field.Path}})
+		s += fmt.Sprintf(", {{$field.Path}}: %d", id)
+	}
+			{{- else if and $field.IsPointer (not $field.Property.Converter)}}
+	if obj.{{$field.Path}} == nil {
+		s += ", {{$field.Path}}: nil"
+	} else {
+		s += fmt.Sprintf(", {{$field.Path}}: {{template "string-verb" $field.Property}}", *obj.{{$field.Path}})
+	}
+			{{- else}}
+	s += fmt.Sprintf(", {{$field.Path}}: {{if $field.Property.Converter}}%v{{else}}{{template "string-verb" $field.Property}}{{end}}", obj.{{$field.Path}})
+			{{- end}}
+		{{- else if $field.IsPointer}}{{/* embedded struct pointer */}}
+	if obj.{{$field.Path}} == nil {
+		s += ", {{$field.Path}}: nil"
+	} else {
+		{{- template "string-fields" $field}}
+	}
+		{{- else}}{{/* embedded struct value */}}{{template "string-fields" $field}}
+		{{- end}}
+	{{- end}}{{end}}
+	return "{{$entity.Name}}{" + s[2:] + "}"
+}
+
+{{end -}}
 // Box provides CRUD access to {{$entity.Name}} objects
 type {{$entity.Name}}Box struct {
 	*objectbox.Box
@@ -401,6 +713,75 @@ func (box *{{$entity.Name}}Box) PutAsync(object *{{$entity.Name}}) (uint64, erro
 	return box.Box.PutAsync(object)
 }
 
+// {{$entityNameCamel}}_AsyncCallbacks collects PutAsyncCallback notifications waiting for the async queue of each store.
+var {{$entityNameCamel}}_AsyncCallbacks = struct {
+	sync.Mutex
+	pending map[*objectbox.ObjectBox][]func(completed bool)
+}{pending: make(map[*objectbox.ObjectBox][]func(completed bool))}
+
+// PutAsyncCallback asynchronously inserts/updates a single object and calls the callback when the write has been
+// processed: with a nil error once the object is stored, or with the error that occurred.
+{{- if $entity.IdProperty.Meta.StringId}}
+// The ID is passed to the callback as a string, the same way it's assigned to {{$entity.Name}}.{{$entity.IdProperty.Meta.Path}}.
+{{- end}}
+//
+// A single goroutine waits for all async operations queued so far to be processed and then calls the callbacks of all
+// the objects put in the meantime, one after another, so keep the callbacks short.
+// Note: a failed update of an already stored object can't be detected and is reported as a success.
+func (box *{{$entity.Name}}Box) PutAsyncCallback(object *{{$entity.Name}}, callback func(id {{if $entity.IdProperty.Meta.StringId}}string{{else}}uint64{{end}}, err error)) {
+	id, err := box.Async().Put(object)
+	{{if $entity.IdProperty.Meta.StringId -}}
+	var objectId = object.{{$entity.IdProperty.Meta.Path}}
+	{{- else -}}
+	var objectId = id
+	{{- end}}
+	if err != nil {
+		go callback(objectId, err)
+		return
+	}
+
+	var notify = func(completed bool) {
+		if !completed {
+			callback(objectId, errors.New("waiting for the async queue to complete failed"))
+		} else if stored, err := box.Box.Contains(id); err != nil {
+			callback(objectId, err)
+		} else if !stored {
+			callback(objectId, errors.New("object was not stored"))
+		} else {
+			callback(objectId, nil)
+		}
+	}
+
+	var callbacks = &{{$entityNameCamel}}_AsyncCallbacks
+	callbacks.Lock()
+	var _, waiting = callbacks.pending[box.ObjectBox]
+	callbacks.pending[box.ObjectBox] = append(callbacks.pending[box.ObjectBox], notify)
+	callbacks.Unlock()
+	if waiting {
+		return // notify is called by the goroutine already waiting for the async queue
+	}
+
+	go func() {
+		for {
+			// take the callbacks queued so far, those added while waiting form the next batch
+			callbacks.Lock()
+			var batch = callbacks.pending[box.ObjectBox]
+			if len(batch) == 0 {
+				delete(callbacks.pending, box.ObjectBox)
+				callbacks.Unlock()
+				return
+			}
+			callbacks.pending[box.ObjectBox] = nil
+			callbacks.Unlock()
+
+			var completed = box.ObjectBox.AwaitAsyncCompletion()
+			for _, notify := range batch {
+				notify(completed)
+			}
+		}
+	}()
+}
+
 // PutMany inserts multiple objects in single transaction.
 // In case {{$entity.IdProperty.Meta.Path}}s are not set on the objects, they would be assigned automatically (auto-increment).
 // 
@@ -415,6 +796,101 @@ func (box *{{$entity.Name}}Box) PutMany(objects []{{if not $.ByValue}}*{{end}}{{
 	return box.Box.PutMany(objects)
 }
 
+// PutBatched inserts multiple objects, splitting them into transactions of at most batchSize objects each.
+// Prefer it over PutMany for very large imports to keep the size of a single transaction limited.
+// If batchSize is not positive, all objects are put in a single transaction, same as PutMany.
+//
+// Returns: IDs of the put objects (in the same order).
+//
+// Note: batches are committed one after another; in case of an error, the previously committed batches stay stored
+// and their IDs are returned together with the error.
+func (box *{{$entity.Name}}Box) PutBatched(objects []{{if not $.ByValue}}*{{end}}{{$entity.Name}}, batchSize int) ([]uint64, error) {
+	if batchSize <= 0 || batchSize >= len(objects) {
+		return box.PutMany(objects)
+	}
+
+	var ids = make([]uint64, 0, len(objects))
+	for start := 0; start < len(objects); start += batchSize {
+		var end = start + batchSize
+		if end > len(objects) {
+			end = len(objects)
+		}
+
+		batchIds, err := box.PutMany(objects[start:end])
+		if err != nil {
+			return ids, err
+		}
+		ids = append(ids, batchIds...)
+	}
+	return ids, nil
+}
+{{range $property := $entity.Meta.PutAllUniqueProperties}}
+// PutAllUnique{{$property.Name}} inserts or updates multiple objects in a single transaction, matching them to the stored objects
+// by the unique property {{$property.Name}}: an object with the same {{$property.Name}} as a stored one replaces it (taking over its ID),
+// the others are inserted. Objects with the same {{$property.Name}} as an object earlier in the slice replace that one (the last
+// one is stored), i.e. importing a slice containing duplicates doesn't violate the unique constraint.
+//
+// Returns: IDs of the created and of the updated objects, each stored object is reported once.
+func (box *{{$entity.Name}}Box) PutAllUnique{{$property.Name}}(objects []{{if not $.ByValue}}*{{end}}{{$entity.Name}}) (created []uint64, updated []uint64, err error) {
+	var query = box.Query({{$entity.Name}}_.{{$property.Name}}.Equals({{if eq $property.GoType "string"}}"", true{{else}}0{{end}}).As(objectbox.Alias("{{$property.Name}}")))
+	defer query.Close()
+
+	// IDs of the objects put by this call, by their {{$property.Name}}
+	var putIds = make(map[{{$property.GoType}}]uint64)
+
+	err = box.ObjectBox.RunInWriteTx(func() error {
+		for {{if $.ByValue}}i{{else}}_, object{{end}} := range objects {
+			{{- if $.ByValue}}
+			var object = &objects[i]
+			{{- end}}
+			var value = {{$property.GoType}}(object.{{$property.Path}})
+
+			// a duplicate within the slice replaces the object put previously, which is already reported
+			if id, isDuplicate := putIds[value]; isDuplicate {
+				if err := {{$entity.Name}}Binding.SetId(object, id); err != nil {
+					return err
+				}
+				if _, err := box.Put(object); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := query.{{if eq $property.GoType "string"}}SetStringParams(objectbox.Alias("{{$property.Name}}"), value){{else}}SetInt64Params(objectbox.Alias("{{$property.Name}}"), int64(value)){{end}}; err != nil {
+				return err
+			}
+			existingIds, err := query.FindIds()
+			if err != nil {
+				return err
+			}
+
+			if len(existingIds) > 0 {
+				if err := {{$entity.Name}}Binding.SetId(object, existingIds[0]); err != nil {
+					return err
+				}
+			}
+
+			id, err := box.Put(object)
+			if err != nil {
+				return err
+			}
+			putIds[value] = id
+
+			if len(existingIds) > 0 {
+				updated = append(updated, id)
+			} else {
+				created = append(created, id)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, updated, nil
+}
+{{end}}
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -456,6 +932,44 @@ func (box *{{$entity.Name}}Box) GetAll() ([]{{if not $.ByValue}}*{{end}}{{$entit
 	return objects.([]{{if not $.ByValue}}*{{end}}{{$entity.Name}}), nil
 }
 
+// ForEach reads all stored objects in batches of 1000 and calls the visitor for each of them, stopping at the first
+// error returned by the visitor (which is then returned by ForEach). As opposed to GetAll(), only the IDs of the objects
+// and a single batch are kept in memory at once, making it suitable for processing large boxes.
+// Each batch is read in a single transaction and the visitor is called outside of it, so the visitor may modify the
+// box; objects removed before their batch is read are skipped.
+func (box *{{$entity.Name}}Box) ForEach(visitor func(*{{$entity.Name}}) error) error {
+	const batchSize = 1000
+
+	query, err := box.Box.QueryOrError()
+	if err != nil {
+		return err
+	}
+	defer query.Close()
+
+	ids, err := query.FindIds()
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(ids); start += batchSize {
+		var end = start + batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		objects, err := box.GetManyExisting(ids[start:end]...)
+		if err != nil {
+			return err
+		}
+		for {{if $.ByValue}}k{{else}}_, object{{end}} := range objects {
+			if err := visitor({{if $.ByValue}}&objects[k]{{else}}object{{end}}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 {{- block "fetch-related" $entity}}
 {{- range $field := .Meta.Fields}}
 	{{if .StandaloneRelation}}
@@ -494,6 +1008,81 @@ func (box *{{$entity.Name}}Box) GetAll() ([]{{if not $.ByValue}}*{{end}}{{$entit
 				return err
 			}
 		{{end}}
+		{{- with .StandaloneRelation.Target}}
+			// {{$field.Name}}Ids returns IDs of the {{.Name}} objects related to the given source object, as currently stored in DB.
+			func (box *{{$field.Entity.Name}}Box) {{$field.Name}}Ids(sourceObject *{{$field.Entity.Name}}) ([]uint64, error) {
+				sourceId, err := {{$field.Entity.Name}}Binding.GetId(sourceObject)
+				if err != nil {
+					return nil, err
+				}
+				return box.RelationIds({{$field.Entity.Name}}_.{{$field.Name}}, sourceId)
+			}
+
+			// AddTo{{$field.Name}} stores relations from the given source object to the target objects, putting targets without an ID first.
+			// The source object must already be stored. Note: sourceObject.{{$field.Name}} isn't changed by this method, a subsequent
+			// Put(sourceObject) replaces the stored relations with the slice contents.
+			func (box *{{$field.Entity.Name}}Box) AddTo{{$field.Name}}(sourceObject *{{$field.Entity.Name}}, targetObjects ...{{if $field.HasPointerElements}}*{{end}}{{.Name}}) error {
+				sourceId, err := {{$field.Entity.Name}}Binding.GetId(sourceObject)
+				if err != nil {
+					return err
+				} else if sourceId == 0 {
+					return errors.New("can't add relations to {{$field.Entity.Name}}.{{$field.Name}} - the source object hasn't been stored yet")
+				}
+				return box.ObjectBox.RunInWriteTx(func() error {
+					for k := range targetObjects {
+						targetId, err := {{.Name}}Binding.GetId({{if not $field.HasPointerElements}}&{{end}}targetObjects[k])
+						if err != nil {
+							return err
+						} else if targetId == 0 {
+							// NOTE Put() has a side-effect of setting the target ID
+							if targetId, err = BoxFor{{.Name}}(box.ObjectBox).Put({{if not $field.HasPointerElements}}&{{end}}targetObjects[k]); err != nil {
+								return err
+							}
+						}
+						if err = box.RelationPut({{$field.Entity.Name}}_.{{$field.Name}}, sourceId, targetId); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			}
+
+			// RemoveFrom{{$field.Name}} removes relations from the given source object to the target objects; the objects themselves are kept.
+			// Note: sourceObject.{{$field.Name}} isn't changed by this method, a subsequent Put(sourceObject) replaces the stored
+			// relations with the slice contents.
+			func (box *{{$field.Entity.Name}}Box) RemoveFrom{{$field.Name}}(sourceObject *{{$field.Entity.Name}}, targetObjects ...{{if $field.HasPointerElements}}*{{end}}{{.Name}}) error {
+				sourceId, err := {{$field.Entity.Name}}Binding.GetId(sourceObject)
+				if err != nil {
+					return err
+				}
+				return box.ObjectBox.RunInWriteTx(func() error {
+					for k := range targetObjects {
+						targetId, err := {{.Name}}Binding.GetId({{if not $field.HasPointerElements}}&{{end}}targetObjects[k])
+						if err != nil {
+							return err
+						}
+						if err = box.RelationRemove({{$field.Entity.Name}}_.{{$field.Name}}, sourceId, targetId); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			}
+		{{end}}
+	{{- else if and .Property .Property.IsBasicType .Property.ModelProperty.RelationTarget (not .HasPointersInPath)}}
+		{{- with .Property.ModelProperty.RelationTarget -}}
+			// Fetch{{$field.Name}} reads the {{.}} object the relation {{$field.Entity.Name}}::{{$field.Name}} points to.
+			// Returns nil if the relation isn't set (zero ID) or the target object doesn't exist (anymore).
+			func (box *{{$field.Entity.Name}}Box) Fetch{{$field.Name}}(sourceObject *{{$field.Entity.Name}}) (*{{.}}, error) {
+				var targetId = {{- if not (eq $field.Property.GoType "uint64")}} uint64( {{end -}}
+					sourceObject.{{$field.Path}}
+					{{- if not (eq $field.Property.GoType "uint64")}} ) {{end}}
+				if targetId == 0 {
+					return nil, nil
+				}
+				return BoxFor{{.}}(box.ObjectBox).Get(targetId)
+			}
+		{{end}}
 	{{- else if not .Property}}{{/* recursively visit fields in embedded structs */}}{{template "fetch-related" $field}}
 	{{- end}}
 {{- end}}{{end}}
@@ -529,13 +1118,79 @@ func (box *{{$entity.Name}}Box) RemoveMany(objects ...*{{$entity.Name}}) (uint64
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyWithErrors deletes multiple objects in a single transaction, removing as many of them as possible.
+// As opposed to RemoveMany, a failure on one of the objects (e.g. an invalid ID or an object that isn't stored)
+// doesn't prevent the others from being removed.
+//
+// Returns: the number of deleted objects and errors aligned to the given objects, i.e. errs[i] is nil if objects[i] was removed.
+// If the transaction itself fails, nothing is removed and all errors are set.
+func (box *{{$entity.Name}}Box) RemoveManyWithErrors(objects ...*{{$entity.Name}}) (uint64, []error) {
+	var errs = make([]error, len(objects))
+	var removed uint64
+	var err = box.ObjectBox.RunInWriteTx(func() error {
+		for k, object := range objects {
+			{{if $entity.IdProperty.Meta.Converter -}}
+			id, err := {{$entity.IdProperty.Meta.TplReadValue "object" ""}}
+			if err != nil {
+				errs[k] = errors.New("converter {{$entity.IdProperty.Meta.Converter}}ToDatabaseValue() failed on {{$entity.Name}}.{{$entity.IdProperty.Meta.Path}}: " + err.Error())
+				continue
+			}
+			{{else -}}
+			var id = {{with $entity.IdProperty -}}
+				{{- if not (eq .Meta.GoType "uint64")}} uint64( {{end -}}
+				object.{{.Meta.Path}}
+				{{- if not (eq .Meta.GoType "uint64")}} ) {{end -}}
+			{{- end}}
+			{{end -}}
+			if id == 0 {
+				errs[k] = errors.New("object has no ID")
+			} else if exists, err := box.Contains(id); err != nil {
+				errs[k] = err
+			} else if !exists {
+				errs[k] = errors.New("object not found")
+			} else if err := box.RemoveId(id); err != nil {
+				errs[k] = err
+			} else {
+				removed++
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		for k := range errs {
+			errs[k] = err
+		}
+		return 0, errs
+	}
+	return removed, errs
+}
+
+// RemoveAllCount removes all stored {{$entity.Name}} objects like RemoveAll() and returns the number of removed objects.
+// Counting and removal run in a single write transaction.
+func (box *{{$entity.Name}}Box) RemoveAllCount() (uint64, error) {
+	var count uint64
+	err := box.ObjectBox.RunInWriteTx(func() error {
+		var err error
+		if count, err = box.Box.Count(); err != nil {
+			return err
+		}
+		return box.Box.RemoveAll()
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // Creates a query with the given conditions. Use the fields of the {{$entity.Name}}_ struct to create conditions.
 // Keep the *{{$entity.Name}}Query if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
 // This is typically a programming error. Use QueryOrError instead if you want the explicit error check.
 func (box *{{$entity.Name}}Box) Query(conditions ...objectbox.Condition) *{{$entity.Name}}Query {
 	return &{{$entity.Name}}Query{
-		box.Box.Query(conditions...),
+		Query: box.Box.Query(conditions...),
+		box:   box,
 	}
 }
 
@@ -545,10 +1200,87 @@ func (box *{{$entity.Name}}Box) QueryOrError(conditions ...objectbox.Condition)
 	if query, err := box.Box.QueryOrError(conditions...); err != nil {
 		return nil, err
 	} else {
-		return &{{$entity.Name}}Query{query}, nil
+		return &{{$entity.Name}}Query{Query: query, box: box}, nil
 	}
 }
 
+{{if $entity.Meta.HasToOneRelations -}}
+// {{$entity.Name}}RelationError describes a stored {{$entity.Name}} object with a to-one relation pointing to a non-existent object
+type {{$entity.Name}}RelationError struct {
+	SourceId uint64 // ID of the {{$entity.Name}} object
+	Property string // name of the relation property
+}
+
+// CheckRelations verifies that to-one relations of all stored {{$entity.Name}} objects point to existing target objects.
+// Only the distinct target IDs actually referenced are checked, i.e. the target boxes aren't read as a whole.
+// Returns the dangling relations found, or nil if all relations are valid.
+func (box *{{$entity.Name}}Box) CheckRelations() ([]{{$entity.Name}}RelationError, error) {
+	var result []{{$entity.Name}}RelationError
+	err := box.ObjectBox.RunInReadTx(func() error {
+		{{- range $property := $entity.Properties}}{{if $property.RelationTarget}}
+		if err := func() error {
+			var query = box.Query({{$entity.Name}}_.{{$property.Meta.Name}}.NotEquals(0))
+			defer query.Close()
+
+			propertyQuery, err := query.PropertyOrError({{$entity.Name}}_.{{$property.Meta.Name}}.Property)
+			if err != nil {
+				return err
+			}
+			defer propertyQuery.Close()
+
+			if err := propertyQuery.Distinct(true); err != nil {
+				return err
+			}
+			targetIds, err := propertyQuery.FindUint64s(nil)
+			if err != nil {
+				return err
+			}
+
+			var missingIds []uint64
+			var targetBox = BoxFor{{$property.RelationTarget}}(box.ObjectBox)
+			for _, targetId := range targetIds {
+				if exists, err := targetBox.Contains(targetId); err != nil {
+					return err
+				} else if !exists {
+					missingIds = append(missingIds, targetId)
+				}
+			}
+			if len(missingIds) == 0 {
+				return nil
+			}
+
+			var danglingQuery = box.Query({{$entity.Name}}_.{{$property.Meta.Name}}.In(missingIds...))
+			defer danglingQuery.Close()
+
+			sourceIds, err := danglingQuery.FindIds()
+			if err != nil {
+				return err
+			}
+			for _, sourceId := range sourceIds {
+				result = append(result, {{$entity.Name}}RelationError{SourceId: sourceId, Property: "{{$property.Name}}"})
+			}
+			return nil
+		}(); err != nil {
+			return err
+		}
+		{{- end}}{{end}}
+		return nil
+	})
+	return result, err
+}
+{{- end}}
+
+{{if $.Observers -}}
+// Subscribe registers the callback to be called with all {{$entity.Name}} objects each time a transaction changing them
+// is committed, e.g. after inserts, updates or removals. The objects are read after the change, an error while reading
+// them is passed to the callback instead. Call Unsubscribe() on the returned observer to stop.
+func (box *{{$entity.Name}}Box) Subscribe(callback func(objects []{{if not $.ByValue}}*{{end}}{{$entity.Name}}, err error)) (*objectbox.DataObserver, error) {
+	return box.ObjectBox.Subscribe({{$entity.Name}}Binding.Id).On(func(_ []objectbox.TypeId) {
+		callback(box.GetAll())
+	})
+}
+
+{{end -}}
 // Async provides access to the default Async Box for asynchronous operations. See {{$entity.Name}}AsyncBox for more information.
 func (box *{{$entity.Name}}Box) Async() *{{$entity.Name}}AsyncBox {
 	return &{{$entity.Name}}AsyncBox{AsyncBox: box.Box.Async()}
@@ -618,6 +1350,7 @@ func (asyncBox *{{$entity.Name}}AsyncBox) Remove(object *{{$entity.Name}}) error
 // box.Query({{$entity.Name}}_.{{$entity.IdProperty.Meta.Name}}.In(42, 47)).Find()
 type {{$entity.Name}}Query struct {
 	*objectbox.Query
+	box *{{$entity.Name}}Box
 }
 
 // Find returns all objects matching the query
@@ -629,6 +1362,43 @@ func (query *{{$entity.Name}}Query) Find() ([]{{if not $.ByValue}}*{{end}}{{$ent
 	return objects.([]{{if not $.ByValue}}*{{end}}{{$entity.Name}}), nil
 }
 
+// FindWithContext returns all objects matching the query, like Find(), but stops early if the context is canceled or
+// its deadline is exceeded, returning the context's error (e.g. context.Canceled) as is.
+// The context is checked before running the query and before reading each batch of 1000 matching objects.
+// Note: the IDs of all matching objects are read first, so the memory used is O(matches) even if canceled early;
+// use Offset() and Limit() to process large results in parts.
+func (query *{{$entity.Name}}Query) FindWithContext(ctx context.Context) ([]{{if not $.ByValue}}*{{end}}{{$entity.Name}}, error) {
+	const batchSize = 1000
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ids, err := query.Query.FindIds()
+	if err != nil {
+		return nil, err
+	}
+
+	var result = make([]{{if not $.ByValue}}*{{end}}{{$entity.Name}}, 0, len(ids))
+	for start := 0; start < len(ids); start += batchSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var end = start + batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		objects, err := query.box.GetManyExisting(ids[start:end]...)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, objects...)
+	}
+	return result, nil
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *{{$entity.Name}}Query) Offset(offset uint64) *{{$entity.Name}}Query {
 	query.Query.Offset(offset)
@@ -640,4 +1410,16 @@ func (query *{{$entity.Name}}Query) Limit(limit uint64) *{{$entity.Name}}Query {
 	query.Query.Limit(limit)
 	return query
 }
+
+// SetParamInt changes the value of an integer condition, previously aliased using Condition.As(objectbox.Alias(alias)).
+// This way, a single query can be executed repeatedly with different parameters.
+func (query *{{$entity.Name}}Query) SetParamInt(alias string, value int64) error {
+	return query.Query.SetInt64Params(objectbox.Alias(alias), value)
+}
+
+// SetParamString changes the value of a string condition, previously aliased using Condition.As(objectbox.Alias(alias)).
+// This way, a single query can be executed repeatedly with different parameters.
+func (query *{{$entity.Name}}Query) SetParamString(alias string, value string) error {
+	return query.Query.SetStringParams(objectbox.Alias(alias), value)
+}
 {{end -}}`))
@@ -26,8 +26,9 @@ import (
 
 // ModelTemplate is used to generate the model initialization code
 var ModelTemplate = template.Must(template.New("model").Parse(
-	`// Code generated by ObjectBox; DO NOT EDIT.
-
+	`{{.Banner}}
+{{with .BuildTag}}//go:build {{.}}
+{{end}}
 package {{.Package}}
 
 import (
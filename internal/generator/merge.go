@@ -24,11 +24,12 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/objectbox/objectbox-generator/v4/internal/generator/model"
 )
 
-func mergeBindingWithModelInfo(currentModel *model.ModelInfo, storedModel *model.ModelInfo) error {
+func mergeBindingWithModelInfo(sourceFile string, currentModel *model.ModelInfo, storedModel *model.ModelInfo) error {
 	// we need to first prepare all entities - otherwise relations wouldn't be able to find them in the model
 	var models = make([]*model.Entity, len(currentModel.Entities))
 	var err error
@@ -37,6 +38,9 @@ func mergeBindingWithModelInfo(currentModel *model.ModelInfo, storedModel *model
 		if err != nil {
 			return fmt.Errorf("entity %s: %s", entity.Name, err)
 		}
+		if err = checkDuplicateEntity(sourceFile, entity, models[k]); err != nil {
+			return err
+		}
 	}
 
 	for k, entity := range currentModel.Entities {
@@ -52,6 +56,24 @@ func mergeBindingWithModelInfo(currentModel *model.ModelInfo, storedModel *model
 	return nil
 }
 
+// checkDuplicateEntity prevents two source definitions (in the same or in different files) from being merged into the
+// same model entity, which would silently overwrite the first one, e.g. by an entity of the same name or the same UID.
+func checkDuplicateEntity(sourceFile string, currentEntity *model.Entity, storedEntity *model.Entity) error {
+	if len(storedEntity.DeclaredIn) == 0 {
+		storedEntity.DeclaredIn = sourceFile
+		storedEntity.DeclaredAs = currentEntity.Name
+		return nil
+	}
+
+	if strings.ToLower(storedEntity.DeclaredAs) == strings.ToLower(currentEntity.Name) {
+		return fmt.Errorf("entity %s declared in %s is already declared in %s; "+
+			"all source files share a single model so entity names must be unique (case insensitive)",
+			currentEntity.Name, sourceFile, storedEntity.DeclaredIn)
+	}
+	return fmt.Errorf("entity %s declared in %s maps to the same model entity %s as entity %s declared in %s",
+		currentEntity.Name, sourceFile, storedEntity.Id, storedEntity.DeclaredAs, storedEntity.DeclaredIn)
+}
+
 func getModelEntity(currentEntity *model.Entity, storedModel *model.ModelInfo) (*model.Entity, error) {
 	if uid, err := currentEntity.Id.GetUidAllowZero(); err != nil {
 		return nil, err
@@ -187,6 +209,24 @@ func getModelProperty(currentProperty *model.Property, storedEntity *model.Entit
 		return nil, fmt.Errorf("duplicate property name (note that property names are case insensitive)")
 	}
 
+	// handle "reset property data" use-case - suggesting a new UID for an existing property.
+	// Like an empty uid annotation, it's a one-time request: the user applies the new UID, which performs the reset.
+	if currentProperty.UidReset {
+		if property == nil {
+			return nil, errors.New("uid:reset annotation can't be applied, the property isn't present in the persisted model")
+		}
+		uid, err := property.Id.GetUid()
+		if err != nil {
+			return nil, err
+		}
+		newUid, err := storedModel.GenerateUid()
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("uid:reset annotation requested a new UID for the property (currently %d): "+
+			"replace the annotation with `objectbox:\"uid:%d\"` to reset the property data", uid, newUid)
+	}
+
 	// handle uid request
 	if currentProperty.UidRequest {
 		if property != nil {
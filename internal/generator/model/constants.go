@@ -38,40 +38,42 @@ var EntityFlagNames = map[EntityFlags]string{
 type PropertyFlags int32
 
 const (
-	PropertyFlagId                   PropertyFlags = 1
-	PropertyFlagNonPrimitiveType     PropertyFlags = 2
-	PropertyFlagNotNull              PropertyFlags = 4
-	PropertyFlagIndexed              PropertyFlags = 8
-	PropertyFlagReserved             PropertyFlags = 16
-	PropertyFlagUnique               PropertyFlags = 32
-	PropertyFlagIdMonotonicSequence  PropertyFlags = 64
-	PropertyFlagIdSelfAssignable     PropertyFlags = 128
-	PropertyFlagIndexPartialSkipNull PropertyFlags = 256
-	PropertyFlagIndexPartialSkipZero PropertyFlags = 512
-	PropertyFlagVirtual              PropertyFlags = 1024
-	PropertyFlagIndexHash            PropertyFlags = 2048
-	PropertyFlagIndexHash64          PropertyFlags = 4096
-	PropertyFlagUnsigned             PropertyFlags = 8192
-	PropertyFlagIdCompanion          PropertyFlags = 16384
+	PropertyFlagId                      PropertyFlags = 1
+	PropertyFlagNonPrimitiveType        PropertyFlags = 2
+	PropertyFlagNotNull                 PropertyFlags = 4
+	PropertyFlagIndexed                 PropertyFlags = 8
+	PropertyFlagReserved                PropertyFlags = 16
+	PropertyFlagUnique                  PropertyFlags = 32
+	PropertyFlagIdMonotonicSequence     PropertyFlags = 64
+	PropertyFlagIdSelfAssignable        PropertyFlags = 128
+	PropertyFlagIndexPartialSkipNull    PropertyFlags = 256
+	PropertyFlagIndexPartialSkipZero    PropertyFlags = 512
+	PropertyFlagVirtual                 PropertyFlags = 1024
+	PropertyFlagIndexHash               PropertyFlags = 2048
+	PropertyFlagIndexHash64             PropertyFlags = 4096
+	PropertyFlagUnsigned                PropertyFlags = 8192
+	PropertyFlagIdCompanion             PropertyFlags = 16384
+	PropertyFlagUniqueOnConflictReplace PropertyFlags = 32768
 )
 
 // PropertyFlagNames assigns a name to each PropertyFlag
 var PropertyFlagNames = map[PropertyFlags]string{
-	PropertyFlagId:                   "Id",
-	PropertyFlagNonPrimitiveType:     "NonPrimitiveType",
-	PropertyFlagNotNull:              "NotNull",
-	PropertyFlagIndexed:              "Indexed",
-	PropertyFlagReserved:             "Reserved",
-	PropertyFlagUnique:               "Unique",
-	PropertyFlagIdMonotonicSequence:  "IdMonotonicSequence",
-	PropertyFlagIdSelfAssignable:     "IdSelfAssignable",
-	PropertyFlagIndexPartialSkipNull: "IndexPartialSkipNull",
-	PropertyFlagIndexPartialSkipZero: "IndexPartialSkipZero",
-	PropertyFlagVirtual:              "Virtual",
-	PropertyFlagIndexHash:            "IndexHash",
-	PropertyFlagIndexHash64:          "IndexHash64",
-	PropertyFlagUnsigned:             "Unsigned",
-	PropertyFlagIdCompanion:          "IdCompanion",
+	PropertyFlagId:                      "Id",
+	PropertyFlagNonPrimitiveType:        "NonPrimitiveType",
+	PropertyFlagNotNull:                 "NotNull",
+	PropertyFlagIndexed:                 "Indexed",
+	PropertyFlagReserved:                "Reserved",
+	PropertyFlagUnique:                  "Unique",
+	PropertyFlagIdMonotonicSequence:     "IdMonotonicSequence",
+	PropertyFlagIdSelfAssignable:        "IdSelfAssignable",
+	PropertyFlagIndexPartialSkipNull:    "IndexPartialSkipNull",
+	PropertyFlagIndexPartialSkipZero:    "IndexPartialSkipZero",
+	PropertyFlagVirtual:                 "Virtual",
+	PropertyFlagIndexHash:               "IndexHash",
+	PropertyFlagIndexHash64:             "IndexHash64",
+	PropertyFlagUnsigned:                "Unsigned",
+	PropertyFlagIdCompanion:             "IdCompanion",
+	PropertyFlagUniqueOnConflictReplace: "UniqueOnConflictReplace",
 }
 
 // PropertyType is an identifier of a property type corresponding with objectbox-c
@@ -91,7 +93,11 @@ const (
 	PropertyTypeRelation     PropertyType = 11
 	PropertyTypeDateNano     PropertyType = 12
 	PropertyTypeByteVector   PropertyType = 23
+	PropertyTypeShortVector  PropertyType = 24
+	PropertyTypeIntVector    PropertyType = 26
+	PropertyTypeLongVector   PropertyType = 27
 	PropertyTypeFloatVector  PropertyType = 28
+	PropertyTypeDoubleVector PropertyType = 29
 	PropertyTypeStringVector PropertyType = 30
 )
 
@@ -110,7 +116,11 @@ var PropertyTypeNames = map[PropertyType]string{
 	PropertyTypeRelation:     "Relation",
 	PropertyTypeDateNano:     "DateNano",
 	PropertyTypeByteVector:   "ByteVector",
+	PropertyTypeShortVector:  "ShortVector",
+	PropertyTypeIntVector:    "IntVector",
+	PropertyTypeLongVector:   "LongVector",
 	PropertyTypeFloatVector:  "FloatVector",
+	PropertyTypeDoubleVector: "DoubleVector",
 	PropertyTypeStringVector: "StringVector",
 }
 
@@ -37,6 +37,8 @@ type Entity struct {
 	UidRequest       bool                  `json:"-"` // used when the user gives an empty uid annotation
 	Meta             EntityMeta            `json:"-"`
 	CurrentlyPresent bool                  `json:"-"`
+	DeclaredIn       string                `json:"-"` // source file of the entity merged in the current run, if any
+	DeclaredAs       string                `json:"-"` // entity name in DeclaredIn (the model name may differ until merged)
 	Comments         []string              `json:"-"`
 	Model            *ModelInfo            `json:"-"`
 }
@@ -86,7 +88,7 @@ func (entity *Entity) Validate() (err error) {
 			if property.Entity == nil {
 				property.Entity = entity
 			} else if property.Entity != entity {
-				return fmt.Errorf("relation %s %s has incorrect parent entity reference",
+				return fmt.Errorf("property %s %s has incorrect parent entity reference",
 					property.Name, property.Id)
 			}
 
@@ -97,13 +99,13 @@ func (entity *Entity) Validate() (err error) {
 				}
 				found = true
 			} else if lastId < property.Id.getIdSafe() {
-				return fmt.Errorf("lastPropertyId %s is lower than relation %s %s",
+				return fmt.Errorf("lastPropertyId %s is lower than property %s %s",
 					entity.LastPropertyId, property.Name, property.Id)
 			}
 		}
 
 		if !found && !searchSliceUid(entity.Model.RetiredPropertyUids, lastUid) {
-			return fmt.Errorf("lastPropertyId %s doesn't match any relation", entity.LastPropertyId)
+			return fmt.Errorf("lastPropertyId %s doesn't match any property", entity.LastPropertyId)
 		}
 	}
 
@@ -0,0 +1,54 @@
+/*
+ * ObjectBox Generator - a build time tool for ObjectBox
+ * Copyright (C) 2024 ObjectBox Ltd. All rights reserved.
+ * https://objectbox.io
+ *
+ * This file is part of ObjectBox Generator.
+ *
+ * ObjectBox Generator is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ * ObjectBox Generator is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with ObjectBox Generator.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package model
+
+import (
+	"testing"
+
+	"github.com/objectbox/objectbox-generator/v4/test/assert"
+)
+
+func TestEntityLastPropertyId(t *testing.T) {
+	var createEntity = func(lastPropertyId IdUid) *Entity {
+		var model = createModelInfo()
+		model.RetiredPropertyUids = []Uid{30}
+		var entity = &Entity{Id: "1:1", Name: "A", LastPropertyId: lastPropertyId, Model: model}
+		entity.Properties = []*Property{
+			{Id: "1:10", Name: "id", Type: PropertyTypeLong, Flags: PropertyFlagId, Entity: entity},
+			{Id: "2:20", Name: "name", Type: PropertyTypeString, Entity: entity},
+		}
+		return entity
+	}
+
+	assert.NoErr(t, createEntity("2:20").Validate())
+	assert.NoErr(t, createEntity("3:30").Validate()) // the last property has been removed
+
+	var invalid = map[IdUid]string{
+		"1:10": "lastPropertyId 1:10 is lower than property name 2:20",
+		"2:21": "lastPropertyId 2:21 doesn't match property name 2:20",
+		"3:31": "lastPropertyId 3:31 doesn't match any property",
+	}
+	for lastPropertyId, expected := range invalid {
+		var err = createEntity(lastPropertyId).Validate()
+		assert.Err(t, err)
+		assert.Eq(t, expected, err.Error())
+	}
+}
@@ -22,10 +22,12 @@ package model
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"sort"
 )
 
 // LoadOrCreateModel reads a model file or creates a new one if it doesn't exist
@@ -38,12 +40,19 @@ func LoadOrCreateModel(path string) (model *ModelInfo, err error) {
 
 // Close and unlock model
 func (model *ModelInfo) Close() error {
+	if model.file == nil {
+		return nil // in-memory model, see LoadOrCreateModelInMemory()
+	}
 	return model.file.Close()
 }
 
 // Write current model data to file
 func (model *ModelInfo) Write() error {
-	data, err := json.MarshalIndent(model, "", "  ")
+	if model.file == nil {
+		return errors.New("the model isn't backed by a file")
+	}
+
+	data, err := model.Marshal()
 	if err != nil {
 		return err
 	}
@@ -63,6 +72,51 @@ func (model *ModelInfo) Write() error {
 	return nil
 }
 
+// LoadOrCreateModelInMemory reads a model file like LoadOrCreateModel() but never creates it; if it doesn't exist, a new
+// model is returned which isn't backed by a file, i.e. Write() fails. Useful to compute changes without applying them.
+func LoadOrCreateModelInMemory(path string) (model *ModelInfo, err error) {
+	if fileExists(path) {
+		return LoadModelFromJSONFile(path)
+	}
+	return createModelInfo(), nil
+}
+
+// Marshal returns the model JSON, exactly as it's written to the file by Write().
+// Entities, properties and relations are written sorted by their IDs, so that the output doesn't depend on the order
+// they were loaded or merged in, keeping diffs of the model file minimal. The model itself isn't reordered.
+func (model *ModelInfo) Marshal() ([]byte, error) {
+	return json.MarshalIndent(model.sortedById(), "", "  ")
+}
+
+// sortedById returns a shallow copy of the model with entities, properties and relations sorted by their IDs.
+// Only the slices are copied (nil ones are kept nil so the JSON doesn't change), the elements are shared.
+func (model *ModelInfo) sortedById() *ModelInfo {
+	var sorted = *model
+	sorted.Entities = make([]*Entity, len(model.Entities))
+	for k, entity := range model.Entities {
+		var sortedEntity = *entity
+		if entity.Properties != nil {
+			sortedEntity.Properties = make([]*Property, len(entity.Properties))
+			copy(sortedEntity.Properties, entity.Properties)
+			sort.SliceStable(sortedEntity.Properties, func(i, j int) bool {
+				return sortedEntity.Properties[i].Id.Less(sortedEntity.Properties[j].Id)
+			})
+		}
+		if entity.Relations != nil {
+			sortedEntity.Relations = make([]*StandaloneRelation, len(entity.Relations))
+			copy(sortedEntity.Relations, entity.Relations)
+			sort.SliceStable(sortedEntity.Relations, func(i, j int) bool {
+				return sortedEntity.Relations[i].Id.Less(sortedEntity.Relations[j].Id)
+			})
+		}
+		sorted.Entities[k] = &sortedEntity
+	}
+	sort.SliceStable(sorted.Entities, func(i, j int) bool {
+		return sorted.Entities[i].Id.Less(sorted.Entities[j].Id)
+	})
+	return &sorted
+}
+
 func fileExists(path string) bool {
 	_, err := os.Stat(path)
 	return !os.IsNotExist(err)
@@ -32,13 +32,17 @@ type IdUid string
 
 // CreateIdUid creates a string representation of ID and UID
 func CreateIdUid(id Id, uid Uid) IdUid {
-	return IdUid(strconv.FormatInt(int64(id), 10) + ":" + strconv.FormatUint(uid, 10))
+	return IdUid(strconv.FormatUint(uint64(id), 10) + ":" + strconv.FormatUint(uid, 10))
 }
 
 var componentNamesErr = [2]string{"id", "uid"}
 
 // Validate performs initial validation of loaded data so that it doesn't have to be checked in each function
 func (str *IdUid) Validate() error {
+	if len(*str) > 0 && len(strings.Split(string(*str), ":")) != 2 {
+		return fmt.Errorf("invalid id format '%s' - expecting exactly one colon", string(*str))
+	}
+
 	if _, err := str.GetUid(); err != nil {
 		return err
 	}
@@ -47,10 +51,6 @@ func (str *IdUid) Validate() error {
 		return err
 	}
 
-	if len(strings.Split(string(*str), ":")) != 2 {
-		return errors.New("invalid id format - too many colons")
-	}
-
 	return nil
 }
 
@@ -98,8 +98,20 @@ func (str IdUid) getComponent(n, bitsize int, allowZero bool) (uint64, error) {
 		return 0, errors.New(componentNamesErr[n] + " is undefined")
 	}
 
-	idStr := strings.Split(string(str), ":")[n]
+	var parts = strings.Split(string(str), ":")
+	if len(parts) <= n {
+		return 0, fmt.Errorf("invalid id format '%s' - expecting ID:UID", string(str))
+	}
+
+	idStr := parts[n]
+	if strings.HasPrefix(idStr, "-") {
+		return 0, fmt.Errorf("%s %s is negative", componentNamesErr[n], idStr)
+	}
+
 	if component, err := strconv.ParseUint(idStr, 10, bitsize); err != nil {
+		if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+			return 0, fmt.Errorf("%s %s exceeds %d-bit range", componentNamesErr[n], idStr, bitsize)
+		}
 		return 0, fmt.Errorf("can't parse '%s' as unsigned int: %s", idStr, err)
 	} else if component == 0 && !allowZero {
 		return 0, errors.New(componentNamesErr[n] + " is zero")
@@ -108,6 +120,19 @@ func (str IdUid) getComponent(n, bitsize int, allowZero bool) (uint64, error) {
 	}
 }
 
+// Less orders by the numeric ID, then by the numeric UID; invalid components are treated as zero
+func (str IdUid) Less(other IdUid) bool {
+	if str.getIdSafe() != other.getIdSafe() {
+		return str.getIdSafe() < other.getIdSafe()
+	}
+	return str.getUidSafe() < other.getUidSafe()
+}
+
+// Equal compares the numeric ID and UID, i.e. ignoring formatting differences such as leading zeros
+func (str IdUid) Equal(other IdUid) bool {
+	return str.getIdSafe() == other.getIdSafe() && str.getUidSafe() == other.getUidSafe()
+}
+
 func (str IdUid) getIdSafe() Id {
 	i, _ := str.GetId()
 	return i
@@ -0,0 +1,105 @@
+/*
+ * ObjectBox Generator - a build time tool for ObjectBox
+ * Copyright (C) 2024 ObjectBox Ltd. All rights reserved.
+ * https://objectbox.io
+ *
+ * This file is part of ObjectBox Generator.
+ *
+ * ObjectBox Generator is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ * ObjectBox Generator is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with ObjectBox Generator.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/objectbox/objectbox-generator/v4/test/assert"
+)
+
+func TestIdUidValidate(t *testing.T) {
+	var valid = []IdUid{"1:1", "4294967295:18446744073709551615", CreateIdUid(4294967295, 1)}
+	for _, idUid := range valid {
+		assert.NoErr(t, idUid.Validate())
+	}
+
+	var invalid = map[IdUid]string{
+		"4294967296:1":           "id 4294967296 exceeds 32-bit range",
+		"5000000000:1":           "id 5000000000 exceeds 32-bit range",
+		"1:18446744073709551616": "uid 18446744073709551616 exceeds 64-bit range",
+		"-1:1":                   "id -1 is negative",
+		"1:-1":                   "uid -1 is negative",
+		"0:1":                    "id is zero",
+		"1:0":                    "uid is zero",
+		"1":                      "invalid id format '1' - expecting exactly one colon",
+		"1:2:3":                  "invalid id format '1:2:3' - expecting exactly one colon",
+		"a:1":                    "can't parse 'a' as unsigned int: strconv.ParseUint: parsing \"a\": invalid syntax",
+	}
+	for idUid, expected := range invalid {
+		var err = idUid.Validate()
+		assert.Err(t, err)
+		assert.Eq(t, expected, err.Error())
+	}
+}
+
+func TestIdUidGet(t *testing.T) {
+	var idUid = CreateIdUid(4294967295, 18446744073709551615)
+	assert.Eq(t, IdUid("4294967295:18446744073709551615"), idUid)
+
+	id, uid, err := idUid.Get()
+	assert.NoErr(t, err)
+	assert.Eq(t, Id(4294967295), id)
+	assert.Eq(t, Uid(18446744073709551615), uid)
+
+	var missingUid = IdUid("1")
+	_, err = missingUid.GetUid()
+	assert.Err(t, err)
+	assert.Eq(t, "invalid id format '1' - expecting ID:UID", err.Error())
+}
+
+func TestIdUidCompare(t *testing.T) {
+	assert.True(t, IdUid("1:5").Less("2:1"))
+	assert.True(t, !IdUid("2:1").Less("1:5"))
+	assert.True(t, IdUid("2:9").Less("10:1")) // numeric, not lexicographic
+
+	// equal IDs are ordered by UIDs
+	assert.True(t, IdUid("1:1").Less("1:2"))
+	assert.True(t, !IdUid("1:2").Less("1:1"))
+	assert.True(t, !IdUid("1:2").Equal("1:1"))
+
+	// equal values are neither less nor greater
+	assert.True(t, !IdUid("1:1").Less("1:1"))
+	assert.True(t, IdUid("1:1").Equal("1:1"))
+	assert.True(t, IdUid("01:1").Equal("1:1"))
+}
+
+func TestModelSortedById(t *testing.T) {
+	var model = createModelInfo()
+	model.Entities = []*Entity{
+		{Id: "10:1", Name: "B", Properties: []*Property{{Id: "2:2", Name: "b2"}, {Id: "1:1", Name: "b1"}}},
+		{Id: "2:2", Name: "A"},
+	}
+	data, err := model.Marshal()
+	assert.NoErr(t, err)
+
+	var written ModelInfo
+	assert.NoErr(t, json.Unmarshal(data, &written))
+	assert.Eq(t, "A", written.Entities[0].Name)
+	assert.Eq(t, "B", written.Entities[1].Name)
+	assert.Eq(t, "b1", written.Entities[1].Properties[0].Name)
+	assert.Eq(t, "b2", written.Entities[1].Properties[1].Name)
+
+	// only the output is sorted, the model keeps its order
+	assert.Eq(t, "B", model.Entities[0].Name)
+	assert.Eq(t, "b2", model.Entities[0].Properties[0].Name)
+}
@@ -156,6 +156,10 @@ func (model *ModelInfo) Validate() (err error) {
 		}
 	}
 
+	if err = model.validateIndexes(); err != nil {
+		return err
+	}
+
 	if len(model.LastRelationId) > 0 || model.hasRelations() {
 		if err = model.LastRelationId.Validate(); err != nil {
 			return fmt.Errorf("lastRelationId: %s", err)
@@ -208,6 +212,40 @@ func (model *ModelInfo) Validate() (err error) {
 	return nil
 }
 
+// validateIndexes checks indexes defined on properties against lastIndexId and retiredIndexUids, e.g. to detect an
+// index that was left orphaned (retired) while still in use, or used by multiple properties at the same time.
+func (model *ModelInfo) validateIndexes() error {
+	var lastId = model.LastIndexId.getIdSafe()
+	var indexes = make(map[Id]*Property)
+
+	for _, entity := range model.Entities {
+		for _, property := range entity.Properties {
+			if property.IndexId == nil || len(*property.IndexId) == 0 {
+				continue
+			}
+
+			var id = property.IndexId.getIdSafe()
+			if len(model.LastIndexId) == 0 || lastId < id {
+				return fmt.Errorf("lastIndexId %s is lower than index %s of property %s.%s",
+					model.LastIndexId, *property.IndexId, entity.Name, property.Name)
+			}
+
+			if other := indexes[id]; other != nil {
+				return fmt.Errorf("index %s of property %s.%s is also used by property %s.%s",
+					*property.IndexId, entity.Name, property.Name, other.Entity.Name, other.Name)
+			}
+			indexes[id] = property
+
+			if searchSliceUid(model.RetiredIndexUids, property.IndexId.getUidSafe()) {
+				return fmt.Errorf("index %s of property %s.%s is listed in retiredIndexUids",
+					*property.IndexId, entity.Name, property.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
 // Finalize should be called after making changes to the model (e.g. from user schema definitions) to verify and update
 // as necessary.
 func (model *ModelInfo) Finalize() error {
@@ -54,6 +54,7 @@ type Property struct {
 	RelationTarget string        `json:"relationTarget,omitempty"`
 	Entity         *Entity       `json:"-"`
 	UidRequest     bool          `json:"-"` // used when the user gives an empty uid annotation
+	UidReset       bool          `json:"-"` // used when the user gives the `uid:reset` annotation to request a new UID
 	HnswParams     *HnswParams   `json:"hnswParams,omitempty"`
 	Meta           PropertyMeta  `json:"-"`
 	Comments       []string      `json:"-"`
@@ -22,11 +22,40 @@ package model
 
 import "fmt"
 
-// CheckRelationCycles finds relations cycles
+// LazyRelationMeta may be implemented by property (to-one) and standalone relation (to-many) Meta.
+// Targets of lazily loaded relations aren't read together with the source object, so they can't cause an infinite
+// recursion and are ignored by CheckRelationCycles, e.g. to allow self-referencing (tree) entities.
+type LazyRelationMeta interface {
+	IsLoadedLazily() bool
+}
+
+func isLoadedLazily(meta interface{}) bool {
+	lazyMeta, ok := meta.(LazyRelationMeta)
+	return ok && lazyMeta.IsLoadedLazily()
+}
+
+// CheckRelationTargets verifies the targets of all to-one relations are entities present in the model
+func (model *ModelInfo) CheckRelationTargets() error {
+	for _, entity := range model.Entities {
+		for _, prop := range entity.Properties {
+			if prop.RelationTarget == "" {
+				continue
+			}
+			if _, err := model.FindEntityByName(prop.RelationTarget); err != nil {
+				return fmt.Errorf("relation %s.%s points to an unknown entity %s", entity.Name, prop.Name, prop.RelationTarget)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckRelationCycles finds relations cycles, ignoring lazily loaded relations (see LazyRelationMeta)
 func (model *ModelInfo) CheckRelationCycles() error {
 	// DFS cycle check, storing relation path in the recursion stack
+	// Only start at entities currently being generated (having Meta): other entities' relations lack the Meta telling
+	// whether they're loaded lazily. Cycles through them are still found if reachable from the current entities.
 	var recursionStack = make(map[*Entity]bool)
-	for _, entity := range model.Entities {
+	for _, entity := range model.EntitiesWithMeta() {
 		if err := entity.checkRelationCycles(&recursionStack, entity.Name); err != nil {
 			return err
 		}
@@ -40,6 +69,10 @@ func (entity *Entity) checkRelationCycles(recursionStack *map[*Entity]bool, path
 
 	// to-many relations
 	for _, rel := range entity.Relations {
+		if isLoadedLazily(rel.Meta) {
+			continue
+		}
+
 		if err := checkRelationCycle(recursionStack, path+"."+rel.Name, rel.Target); err != nil {
 			return err
 		}
@@ -47,7 +80,7 @@ func (entity *Entity) checkRelationCycles(recursionStack *map[*Entity]bool, path
 
 	// to-one relations
 	for _, prop := range entity.Properties {
-		if prop.RelationTarget == "" {
+		if prop.RelationTarget == "" || isLoadedLazily(prop.Meta) {
 			continue
 		}
 
@@ -20,7 +20,18 @@
 
 package generator
 
-import "math/rand"
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+)
 
 // Options provide configuration for the generator
 type Options struct {
@@ -30,6 +41,170 @@ type Options struct {
 	OutPath        string
 	OutHeadersPath string
 
-	// NOTE - currently only supports one
+	// GeneratedBy is used in the "Code generated by ...; DO NOT EDIT." banner of the generated files.
+	// Defaults to DefaultGeneratedBy if empty.
+	GeneratedBy string
+
+	// EmitUnchanged forces rewriting generated files even if their content hasn't changed.
+	// By default, such files are left untouched so that their modification time is preserved.
+	EmitUnchanged bool
+
+	// OutWriter, if set, receives the generated source code instead of the file system.
+	// It's called once for each generated file, with the path the file would otherwise be written to.
+	// Note: the model JSON file is still written to the file system as it's required for subsequent runs.
+	// Generated files already present on the file system are never removed, neither by the implicit directory cleanup
+	// nor by CleanOrphans.
+	OutWriter func(file string) (io.Writer, error)
+
+	// Strict turns some warnings into errors, e.g. an entity without any property besides the ID.
+	Strict bool
+
+	// LineEndings of the generated source files, independent of the host OS: LineEndingsLF (default) or LineEndingsCRLF.
+	// Note: the model JSON file always uses LF.
+	LineEndings string
+
+	// DryRun computes the merged model and all generated files but doesn't write or remove anything.
+	// Instead, the files that would be created, overwritten or removed are printed to the standard output.
+	DryRun bool
+
+	// Check verifies the generated files are up to date instead of writing them. Like DryRun, nothing is written or
+	// removed; Process returns an error listing the files that would be created, overwritten or removed.
+	Check bool
+
+	// Force makes cleaning remove files recognized as generated by their name even without the generated-file banner.
+	// By default, such files are kept (with a warning) as they're likely hand-written.
+	Force bool
+
+	// Suffix is inserted between the source file name and the extension of the generated binding files, e.g. ".gen"
+	// results in "entity.gen.go". Defaults to DefaultSuffix if empty. Files with this suffix are recognized as
+	// generated, e.g. when cleaning, so it must be the same for generation and cleaning.
+	Suffix string
+
+	// Parallel is the number of source files parsed concurrently, if supported by the code generator, see
+	// ConcurrentSourceParser. Values below 2 parse the files one by one. Regardless of this setting, the parsed sources
+	// are merged into the model and their bindings written in the same order, i.e. the output is identical.
+	Parallel int
+
+	// Logger, if set, receives detailed diagnostics, e.g. the IDs/UIDs assigned to new entities and the files written.
+	Logger *log.Logger
+
+	// dryRunFiles collects the files (cleaned paths) that would be written during a dry run
+	dryRunFiles map[string]bool
+
+	// staleFiles collects the files that aren't up to date with the action that would be taken, see Check
+	staleFiles map[string]string
+
+	// CodeGenerator produces bindings for the sources it recognizes, see CodeGenerator.IsSourceFile().
 	CodeGenerator CodeGenerator
+
+	// AdditionalCodeGenerators are executed after CodeGenerator in the same run, e.g. to generate both C and C++ code.
+	// All of them share a single model so IDs and UIDs are identical across languages; the model JSON is written once.
+	AdditionalCodeGenerators []CodeGenerator
+}
+
+// CodeGenerators returns CodeGenerator followed by AdditionalCodeGenerators.
+func (options Options) CodeGenerators() []CodeGenerator {
+	return append([]CodeGenerator{options.CodeGenerator}, options.AdditionalCodeGenerators...)
+}
+
+// withCodeGenerator returns a copy of the options with the given CodeGenerator and no additional ones.
+func (options Options) withCodeGenerator(gen CodeGenerator) Options {
+	options.CodeGenerator = gen
+	options.AdditionalCodeGenerators = nil
+	return options
+}
+
+// Supported values of Options.LineEndings
+const (
+	LineEndingsLF   = "lf"
+	LineEndingsCRLF = "crlf"
+)
+
+// Banner returns the first line of the generated files, recognized as a "generated file" marker by tools.
+func (options Options) Banner() string {
+	var by = options.GeneratedBy
+	if len(by) == 0 {
+		by = DefaultGeneratedBy
+	}
+	return "// Code generated by " + by + "; DO NOT EDIT."
+}
+
+// BindingSuffix returns Options.Suffix or DefaultSuffix if it isn't set.
+func (options Options) BindingSuffix() string {
+	if len(options.Suffix) == 0 {
+		return DefaultSuffix
+	}
+	return options.Suffix
+}
+
+// reportDryRunWrite prints what WriteFile would do with the given file, if anything
+func (options Options) reportDryRunWrite(file string, data []byte, emitUnchanged bool) {
+	var action string
+	if existing, err := ioutil.ReadFile(file); os.IsNotExist(err) {
+		action = "create"
+	} else if emitUnchanged || err != nil || !bytes.Equal(existing, data) {
+		action = "overwrite"
+	} else {
+		return
+	}
+	options.reportDryRun(action, file)
+}
+
+// reportDryRun prints the action a dry run would take on the given file, or records the file as stale, see Check
+func (options Options) reportDryRun(action, file string) {
+	if options.staleFiles != nil {
+		options.staleFiles[file] = action
+	} else {
+		fmt.Printf("Would %s %s\n", action, file)
+	}
+}
+
+// staleFilesError returns an error listing files recorded by reportDryRun in the Check mode, if there are any
+func (options Options) staleFilesError() error {
+	if len(options.staleFiles) == 0 {
+		return nil
+	}
+
+	var files = make([]string, 0, len(options.staleFiles))
+	for file := range options.staleFiles {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	var message = "generated files are not up to date, run the generator to update them:"
+	for _, file := range files {
+		message += fmt.Sprintf("\n  would %s %s", options.staleFiles[file], file)
+	}
+	return errors.New(message)
+}
+
+// WriteOutput writes a generated file, converting it to the configured LineEndings, either to the OutWriter (if configured) or to the file system using WriteFile.
+func (options Options) WriteOutput(file string, data []byte, permSource string) error {
+	data = bytes.Replace(data, []byte("\r\n"), []byte("\n"), -1)
+	if options.LineEndings == LineEndingsCRLF {
+		data = bytes.Replace(data, []byte("\n"), []byte("\r\n"), -1)
+	}
+
+	if options.DryRun {
+		if options.dryRunFiles != nil {
+			options.dryRunFiles[filepath.Clean(file)] = true
+		}
+		options.reportDryRunWrite(file, data, options.EmitUnchanged)
+		return nil
+	}
+
+	if options.Logger != nil {
+		options.Logger.Printf("Writing %s", file)
+	}
+
+	if options.OutWriter == nil {
+		return WriteFile(file, data, permSource, options.EmitUnchanged)
+	}
+
+	writer, err := options.OutWriter(file)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	return err
 }
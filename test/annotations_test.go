@@ -0,0 +1,163 @@
+/*
+ * ObjectBox Generator - a build time tool for ObjectBox
+ * Copyright (C) 2020-2024 ObjectBox Ltd. All rights reserved.
+ * https://objectbox.io
+ *
+ * This file is part of ObjectBox Generator.
+ *
+ * ObjectBox Generator is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ * ObjectBox Generator is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with ObjectBox Generator.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package test
+
+import (
+	"testing"
+
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/binding"
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/model"
+	"github.com/objectbox/objectbox-generator/v4/test/assert"
+)
+
+var supportedIdAnnotations = map[string]bool{"id": true, "uid": true, "assignable": true}
+
+func processIdAnnotations(tags string) (*model.Property, error) {
+	var annotations = make(map[string]*binding.Annotation)
+	if err := binding.ParseAnnotations(tags, &annotations, supportedIdAnnotations); err != nil {
+		return nil, err
+	}
+	var property = model.CreateProperty(model.CreateEntity(&model.ModelInfo{}, 1, 1), 1, 1)
+	property.Type = model.PropertyTypeLong
+	var field = binding.CreateField(property)
+	return property, field.ProcessAnnotations(annotations)
+}
+
+func TestIdAnnotationsOrder(t *testing.T) {
+	var permutations = []string{
+		"id,uid=123,assignable",
+		"id,assignable,uid=123",
+		"uid=123,id,assignable",
+		"uid=123,assignable,id",
+		"assignable,id,uid=123",
+		"assignable,uid=123,id",
+		"id(assignable),uid=123",
+		"uid=123,id(assignable)",
+		"id(assignable),assignable,uid=123",
+	}
+
+	for _, tags := range permutations {
+		property, err := processIdAnnotations(tags)
+		assert.NoErr(t, err)
+		assert.Eq(t, model.PropertyFlagId|model.PropertyFlagIdSelfAssignable, property.Flags)
+		assert.Eq(t, model.CreateIdUid(1, 123), property.Id)
+	}
+}
+
+func TestIdAnnotationsInvalid(t *testing.T) {
+	var invalid = map[string]string{
+		"assignable":                "assignable annotation is only valid on an ID property, use it together with `id`",
+		"uid=123,assignable":        "assignable annotation is only valid on an ID property, use it together with `id`",
+		"id,assignable=true":        "assignable annotation value must be empty",
+		"assignable=true,id":        "assignable annotation value must be empty",
+		"id(assignable=true)":       "'id' annotation's 'assignable' attribute value must be empty",
+		"id,assignable,uid=invalid": "can't parse uid - strconv.ParseUint: parsing \"invalid\": invalid syntax",
+	}
+
+	for tags, expected := range invalid {
+		_, err := processIdAnnotations(tags)
+		assert.Err(t, err)
+		assert.Eq(t, expected, err.Error())
+	}
+}
+
+var supportedTransientAnnotations = map[string]bool{"-": true, "transient": true, "index": true}
+
+func processTransientAnnotations(tags string) (*binding.Field, error) {
+	var annotations = make(map[string]*binding.Annotation)
+	if err := binding.ParseAnnotations(tags, &annotations, supportedTransientAnnotations); err != nil {
+		return nil, err
+	}
+	var field = binding.CreateField(model.CreateProperty(model.CreateEntity(&model.ModelInfo{}, 1, 1), 1, 1))
+	return field, field.ProcessAnnotations(annotations)
+}
+
+func TestTransientAnnotations(t *testing.T) {
+	for _, tags := range []string{"-", "transient"} {
+		field, err := processTransientAnnotations(tags)
+		assert.NoErr(t, err)
+		assert.True(t, field.IsSkipped)
+	}
+
+	var invalid = map[string]string{
+		"-,index":         "to ignore the property, use only `objectbox:\"-\"` as an annotation",
+		"transient,index": "to ignore the property, use only `objectbox:\"transient\"` as an annotation",
+		"transient=true":  "to ignore the property, use only `objectbox:\"transient\"` as an annotation",
+	}
+
+	for tags, expected := range invalid {
+		_, err := processTransientAnnotations(tags)
+		assert.Err(t, err)
+		assert.Eq(t, expected, err.Error())
+	}
+}
+
+func TestPropertyNameCase(t *testing.T) {
+	// DB names are taken verbatim, both from the field name and from the `name` annotation
+	for tags, expected := range map[string]string{"": "CreatedAt", "name=createdAt": "createdAt", "name=CREATED_AT": "CREATED_AT"} {
+		var annotations = make(map[string]*binding.Annotation)
+		assert.NoErr(t, binding.ParseAnnotations(tags, &annotations, map[string]bool{"name": true}))
+
+		var field = binding.CreateField(model.CreateProperty(model.CreateEntity(&model.ModelInfo{}, 1, 1), 1, 1))
+		field.SetName("CreatedAt")
+		assert.NoErr(t, field.ProcessAnnotations(annotations))
+		assert.Eq(t, "CreatedAt", field.Name)
+		assert.Eq(t, expected, field.ModelProperty.Name)
+	}
+}
+
+func TestDateAnnotations(t *testing.T) {
+	var supported = map[string]bool{"date": true, "date-nano": true}
+	var process = func(tags string, propertyType model.PropertyType) (*model.Property, error) {
+		var annotations = make(map[string]*binding.Annotation)
+		if err := binding.ParseAnnotations(tags, &annotations, supported); err != nil {
+			return nil, err
+		}
+		var property = model.CreateProperty(model.CreateEntity(&model.ModelInfo{}, 1, 1), 1, 1)
+		property.Type = propertyType
+		return property, binding.CreateField(property).ProcessAnnotations(annotations)
+	}
+
+	for tags, expected := range map[string]model.PropertyType{"date": model.PropertyTypeDate, "date-nano": model.PropertyTypeDateNano} {
+		property, err := process(tags, model.PropertyTypeLong)
+		assert.NoErr(t, err)
+		assert.Eq(t, expected, property.Type)
+
+		_, err = process(tags, model.PropertyTypeInt)
+		assert.Err(t, err)
+		assert.Eq(t, "invalid underlying type 'Int' for date/date-nano field; expecting long (signed or unsigned)", err.Error())
+	}
+
+	_, err := process("date,date-nano", model.PropertyTypeLong)
+	assert.Err(t, err)
+	assert.Eq(t, "date and date-nano annotations cannot be used at the same time", err.Error())
+}
+
+func TestUnknownAnnotations(t *testing.T) {
+	var supported = map[string]bool{"-": true, "id": true, "index": true, "name": true, "uid": true, "unique": true}
+	var annotations = make(map[string]*binding.Annotation)
+	assert.NoErr(t, binding.ParseAnnotations("id index name:value uid:1 unique", &annotations, supported))
+	assert.Eq(t, 5, len(annotations))
+
+	var err = binding.ParseAnnotations("uniqeu", &map[string]*binding.Annotation{}, supported)
+	assert.Err(t, err)
+	assert.Eq(t, "unknown annotation 'uniqeu'", err.Error())
+}
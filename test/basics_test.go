@@ -21,13 +21,32 @@
 package test
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io"
 	"io/ioutil"
+	"log"
+	"math/rand"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/objectbox/objectbox-generator/v4/internal/generator"
+	cgenerator "github.com/objectbox/objectbox-generator/v4/internal/generator/c"
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/flatbuffersc"
+	gogenerator "github.com/objectbox/objectbox-generator/v4/internal/generator/go"
+	"github.com/objectbox/objectbox-generator/v4/internal/generator/model"
 	"github.com/objectbox/objectbox-generator/v4/test/assert"
 )
 
@@ -65,3 +84,1521 @@ func TestPathPatterns(t *testing.T) {
 	assert.True(t, generator.PathIsDirOrPattern("/dir[012]/file.ext"))
 	assert.True(t, generator.PathIsDirOrPattern("*.ext"))
 }
+
+func TestGeneratedFileBanner(t *testing.T) {
+	assert.Eq(t, "// Code generated by ObjectBox; DO NOT EDIT.", generator.Options{}.Banner())
+	assert.NoErr(t, generator.ValidateBanner(generator.Options{}.Banner()))
+	assert.NoErr(t, generator.ValidateBanner(generator.Options{GeneratedBy: "custom tool (v1.2)"}.Banner()))
+	assert.Err(t, generator.ValidateBanner(generator.Options{GeneratedBy: "multi\nline"}.Banner()))
+
+	// all the generated files used in comparison tests must be recognized by the Go generated-file convention
+	err := filepath.Walk(filepath.Join("comparison", "testdata"), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".expected") || strings.HasSuffix(path, ".json.expected") {
+			return err
+		}
+		if name := info.Name(); !strings.Contains(name, ".obx.") && !strings.HasPrefix(name, "objectbox-model.") {
+			return nil
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var firstLine = strings.SplitN(string(content), "\n", 2)[0]
+		if err := generator.ValidateBanner(firstLine); err != nil {
+			t.Errorf("%s: %s", path, err)
+		}
+		return nil
+	})
+	assert.NoErr(t, err)
+}
+
+func TestWriteFileUnchanged(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var file = filepath.Join(dir, "file.obx.go")
+	var content = []byte("content")
+	assert.NoErr(t, ioutil.WriteFile(file, content, 0600))
+
+	var past = time.Now().Add(-time.Hour).Truncate(time.Second)
+	var mtime = func() time.Time {
+		info, err := os.Stat(file)
+		assert.NoErr(t, err)
+		return info.ModTime()
+	}
+
+	// same content - the file is skipped and its modification time preserved
+	assert.NoErr(t, os.Chtimes(file, past, past))
+	assert.NoErr(t, generator.WriteFile(file, content, file, false))
+	assert.Eq(t, past, mtime())
+
+	// same content with emitUnchanged - the file is rewritten
+	assert.NoErr(t, generator.WriteFile(file, content, file, true))
+	assert.True(t, mtime().After(past))
+
+	// different content - the file is always written
+	assert.NoErr(t, os.Chtimes(file, past, past))
+	assert.NoErr(t, generator.WriteFile(file, []byte("changed"), file, false))
+	assert.True(t, mtime().After(past))
+	written, err := ioutil.ReadFile(file)
+	assert.NoErr(t, err)
+	assert.Eq(t, "changed", string(written))
+}
+
+func TestWriteFilePermissions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var mode = func(file string) os.FileMode {
+		info, err := os.Stat(file)
+		assert.NoErr(t, err)
+		return info.Mode().Perm()
+	}
+
+	// neither the file nor the permission source exist - the default mode is used (reduced by the umask)
+	var file = filepath.Join(dir, "new.obx.go")
+	assert.NoErr(t, generator.WriteFile(file, []byte("content"), filepath.Join(dir, "missing.go"), false))
+	written, err := ioutil.ReadFile(file)
+	assert.NoErr(t, err)
+	assert.Eq(t, "content", string(written))
+
+	if runtime.GOOS == "windows" {
+		t.Log("skipping permission bits checks on Windows")
+		return
+	}
+	assert.Eq(t, os.FileMode(0), mode(file)&^0644)
+	assert.Eq(t, os.FileMode(0600), mode(file)&0600)
+
+	// execute bits of the permission source aren't copied to the generated file
+	var source = filepath.Join(dir, "source.go")
+	assert.NoErr(t, ioutil.WriteFile(source, []byte("package test"), 0600))
+	assert.NoErr(t, os.Chmod(source, 0750))
+	file = filepath.Join(dir, "source.obx.go")
+	assert.NoErr(t, generator.WriteFile(file, []byte("content"), source, false))
+	assert.Eq(t, os.FileMode(0), mode(file)&0111)
+	assert.Eq(t, os.FileMode(0600), mode(file)&0600)
+}
+
+// writeEntitySources creates the given number of Go source files, each with an entity linking to the previous one
+func writeEntitySources(tb testing.TB, dir string, count int) {
+	for i := 0; i < count; i++ {
+		var source = fmt.Sprintf("package test\n\ntype Entity%d struct {\n\tId uint64\n\tName string `objectbox:\"index\"`\n", i)
+		if i > 0 {
+			source += fmt.Sprintf("\tPrevious *Entity%d `objectbox:\"link\"`\n", i-1)
+		}
+		source += "}\n"
+		if err := ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("entity%d.go", i)), []byte(source), 0600); err != nil {
+			tb.Fatal(err)
+		}
+	}
+}
+
+// processParallel generates the sources in dir with a fixed random seed and returns all generated files by their name
+func processParallel(tb testing.TB, dir string, parallel int) map[string]string {
+	var outputs = make(map[string]*bytes.Buffer)
+	var options = generator.Options{
+		InPath:        dir,
+		ModelInfoFile: generator.ModelInfoFile(dir),
+		CodeGenerator: &gogenerator.GoGenerator{},
+		Rand:          rand.New(rand.NewSource(1)),
+		Parallel:      parallel,
+		OutWriter: func(file string) (io.Writer, error) {
+			outputs[filepath.Base(file)] = &bytes.Buffer{}
+			return outputs[filepath.Base(file)], nil
+		},
+	}
+	if err := generator.Process(options); err != nil {
+		tb.Fatal(err)
+	}
+
+	var files = make(map[string]string)
+	for name, output := range outputs {
+		files[name] = output.String()
+	}
+	modelJson, err := ioutil.ReadFile(generator.ModelInfoFile(dir))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	files[filepath.Base(generator.ModelInfoFile(dir))] = string(modelJson)
+	return files
+}
+
+func TestParallel(t *testing.T) {
+	const count = 30
+	var outputs = make([]map[string]string, 0)
+	for _, parallel := range []int{1, 4, count * 2} {
+		dir, err := ioutil.TempDir("", "objectbox-generator-test")
+		assert.NoErr(t, err)
+		defer os.RemoveAll(dir)
+
+		writeEntitySources(t, dir, count)
+		outputs = append(outputs, processParallel(t, dir, parallel))
+	}
+
+	// the output doesn't depend on the number of workers, including IDs and UIDs assigned to the new entities
+	assert.Eq(t, count+2, len(outputs[0]))
+	for _, files := range outputs[1:] {
+		assert.Eq(t, len(outputs[0]), len(files))
+		for name, content := range outputs[0] {
+			assert.Eq(t, content, files[name])
+		}
+	}
+
+	// a parse error is reported the same way, regardless of the number of workers
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+	writeEntitySources(t, dir, count)
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "entity7.go"), []byte("package test\n\ntype Entity7 struct {\n\tName string\n}\n"), 0600))
+	for _, parallel := range []int{1, 4} {
+		var err = generator.Process(generator.Options{
+			InPath:        dir,
+			ModelInfoFile: generator.ModelInfoFile(dir),
+			CodeGenerator: &gogenerator.GoGenerator{},
+			Parallel:      parallel,
+			OutWriter: func(file string) (io.Writer, error) {
+				return ioutil.Discard, nil
+			},
+		})
+		assert.Err(t, err)
+		assert.True(t, strings.Contains(err.Error(), "entity7.go"))
+	}
+}
+
+func BenchmarkParallel(b *testing.B) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeEntitySources(b, dir, 30)
+
+	for _, parallel := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", parallel), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := os.RemoveAll(generator.ModelInfoFile(dir)); err != nil {
+					b.Fatal(err)
+				}
+				processParallel(b, dir, parallel)
+			}
+		})
+	}
+}
+
+func TestOutWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "entity.go")
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype Entity struct {\n\tId uint64\n\tName string\n}\n"), 0600))
+
+	var outputs = make(map[string]*bytes.Buffer)
+	var options = generator.Options{
+		InPath:        sourceFile,
+		CodeGenerator: &gogenerator.GoGenerator{},
+		OutWriter: func(file string) (io.Writer, error) {
+			outputs[filepath.Base(file)] = &bytes.Buffer{}
+			return outputs[filepath.Base(file)], nil
+		},
+	}
+	assert.NoErr(t, generator.Process(options))
+
+	assert.Eq(t, 2, len(outputs))
+	assert.True(t, strings.Contains(outputs["entity.obx.go"].String(), "func BoxForEntity("))
+	assert.True(t, strings.Contains(outputs["objectbox-model.go"].String(), "model.RegisterBinding(EntityBinding)"))
+
+	// generated sources must not have been written to the file system; the model JSON file is still written
+	_, err = os.Stat(filepath.Join(dir, "entity.obx.go"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "objectbox-model.go"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "objectbox-model.json"))
+	assert.NoErr(t, err)
+}
+
+func TestStrconvImport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "entity.go")
+	var binding bytes.Buffer
+	var options = generator.Options{
+		InPath:        sourceFile,
+		ModelInfoFile: generator.ModelInfoFile(dir),
+		CodeGenerator: &gogenerator.GoGenerator{},
+		OutWriter: func(file string) (io.Writer, error) {
+			if filepath.Base(file) == "entity.obx.go" {
+				binding.Reset()
+				return &binding, nil
+			}
+			return ioutil.Discard, nil
+		},
+	}
+
+	// the import must be present exactly when the generated code uses strconv, i.e. the float-format converter
+	var sources = []struct {
+		fields  string
+		strconv bool
+	}{
+		{"\tPrice float64 `objectbox:\"type:string converter(fmt=%.2f)\"`\n", true},
+		{"\tPrice float64\n", false},
+		{"\tName string `objectbox:\"unique\"`\n", false},
+		{"\tPrice float32 `objectbox:\"type:string converter(fmt=%g)\"`\n", true},
+	}
+	for _, source := range sources {
+		assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n"+source.fields+"}\n"), 0600))
+		assert.NoErr(t, generator.Process(options))
+		assert.Eq(t, source.strconv, strings.Contains(binding.String(), `"strconv"`))
+		assert.Eq(t, source.strconv, strings.Contains(binding.String(), "strconv.ParseFloat("))
+	}
+}
+
+func TestPutAllUniqueQuery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "entity.go")
+	var binding bytes.Buffer
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tName string `objectbox:\"unique\"`\n}\n"), 0600))
+	assert.NoErr(t, generator.Process(generator.Options{
+		InPath:        sourceFile,
+		CodeGenerator: &gogenerator.GoGenerator{},
+		OutWriter: func(file string) (io.Writer, error) {
+			if filepath.Base(file) == "entity.obx.go" {
+				return &binding, nil
+			}
+			return ioutil.Discard, nil
+		},
+	}))
+
+	f, err := parser.ParseFile(token.NewFileSet(), "entity.obx.go", binding.Bytes(), 0)
+	assert.NoErr(t, err)
+	var fn *ast.FuncDecl
+	for _, decl := range f.Decls {
+		if decl, isFunc := decl.(*ast.FuncDecl); isFunc && decl.Name.Name == "PutAllUniqueName" {
+			fn = decl
+		}
+	}
+	assert.True(t, fn != nil)
+
+	// the query is built once per call (not per object) and closed; objects repeated in the slice are deduplicated
+	var queries, closes, loopQueries, duplicateChecks int
+	var inLoop bool
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.RangeStmt:
+			inLoop = true
+			ast.Inspect(node.Body, func(node ast.Node) bool {
+				if call, isCall := node.(*ast.CallExpr); isCall {
+					if selector, isSelector := call.Fun.(*ast.SelectorExpr); isSelector && selector.Sel.Name == "Query" {
+						loopQueries++
+					}
+				}
+				if index, isIndex := node.(*ast.IndexExpr); isIndex {
+					if ident, isIdent := index.X.(*ast.Ident); isIdent && ident.Name == "putIds" {
+						duplicateChecks++
+					}
+				}
+				return true
+			})
+			return false
+		case *ast.DeferStmt:
+			if selector, isSelector := node.Call.Fun.(*ast.SelectorExpr); isSelector && selector.Sel.Name == "Close" {
+				closes++
+			}
+		case *ast.CallExpr:
+			if selector, isSelector := node.Fun.(*ast.SelectorExpr); isSelector && selector.Sel.Name == "Query" && !inLoop {
+				queries++
+			}
+		}
+		return true
+	})
+	assert.Eq(t, 1, queries)
+	assert.Eq(t, 1, closes)
+	assert.Eq(t, 0, loopQueries)
+	assert.Eq(t, 2, duplicateChecks)
+}
+
+func TestProcessModel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "entity.go")
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tName string\n}\n\ntype B struct {\n\tId uint64\n\tValue int\n}\n"), 0600))
+
+	modelInfo, err := generator.ProcessModel(generator.Options{InPath: sourceFile, CodeGenerator: &gogenerator.GoGenerator{}})
+	assert.NoErr(t, err)
+
+	var names []string
+	for _, entity := range modelInfo.Entities {
+		names = append(names, entity.Name)
+	}
+	assert.Eq(t, []string{"A", "B"}, names)
+	assert.Eq(t, 2, len(modelInfo.Entities[0].Properties))
+
+	// the returned model is the one written to the JSON file
+	storedModel, err := model.LoadModelFromJSONFile(generator.ModelInfoFile(dir))
+	assert.NoErr(t, err)
+	assert.NoErr(t, storedModel.Close())
+	assert.Eq(t, storedModel.LastEntityId, modelInfo.LastEntityId)
+	assert.Eq(t, storedModel.Entities[0].Id, modelInfo.Entities[0].Id)
+}
+
+func TestModelFileImportsStable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var generateModelFile = func() string {
+		var output bytes.Buffer
+		var options = generator.Options{
+			InPath:        dir,
+			ModelInfoFile: generator.ModelInfoFile(dir),
+			CodeGenerator: &gogenerator.GoGenerator{},
+			OutWriter: func(file string) (io.Writer, error) {
+				if filepath.Base(file) == "objectbox-model.go" {
+					return &output, nil
+				}
+				return ioutil.Discard, nil
+			},
+		}
+		assert.NoErr(t, generator.Process(options))
+		return output.String()
+	}
+
+	var importsBlock = func(source string) string {
+		var start = strings.Index(source, "import (")
+		assert.True(t, start >= 0)
+		var end = strings.Index(source[start:], ")")
+		return source[start : start+end+1]
+	}
+
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "a.go"), []byte("package test\n\ntype A struct {\n\tId uint64\n}\n"), 0600))
+	var before = generateModelFile()
+
+	// adding an entity with a string ID must not change the imports of the model file
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "b.go"), []byte("package test\n\ntype B struct {\n\tId string `objectbox:\"id\"`\n}\n"), 0600))
+	var after = generateModelFile()
+
+	assert.True(t, before != after)
+	assert.Eq(t, importsBlock(before), importsBlock(after))
+	assert.Eq(t, "import (\n\t\"github.com/objectbox/objectbox-go/objectbox\"\n)", importsBlock(after))
+}
+
+func TestCVectorAlignment(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "schema.fbs")
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("table Entity {\n\tid:ulong;\n\tbytes:[ubyte];\n\tfloats:[float];\n}\n"), 0600))
+
+	var generate = func(alignment int) string {
+		var output bytes.Buffer
+		var options = generator.Options{
+			InPath:        sourceFile,
+			CodeGenerator: &cgenerator.CGenerator{PlainC: true, LangVersion: -1, VectorAlignment: alignment},
+			OutWriter: func(file string) (io.Writer, error) {
+				if strings.HasSuffix(file, ".obx.h") {
+					return &output, nil
+				}
+				return ioutil.Discard, nil
+			},
+		}
+		assert.NoErr(t, generator.Process(options))
+		return output.String()
+	}
+
+	// natural alignment, i.e. the element size
+	var source = generate(0)
+	assert.True(t, strings.Contains(source, "object->bytes_len, sizeof(uint8_t), 1, FLATBUFFERS_COUNT_MAX(sizeof(uint8_t))"))
+	assert.True(t, strings.Contains(source, "object->floats_len, sizeof(float), 4, FLATBUFFERS_COUNT_MAX(sizeof(float))"))
+
+	// overridden minimum alignment
+	source = generate(8)
+	assert.True(t, strings.Contains(source, "object->bytes_len, sizeof(uint8_t), 8, FLATBUFFERS_COUNT_MAX(sizeof(uint8_t))"))
+	assert.True(t, strings.Contains(source, "object->floats_len, sizeof(float), 8, FLATBUFFERS_COUNT_MAX(sizeof(float))"))
+}
+
+func TestCppAccessorsCollision(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "schema.fbs")
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("table Entity {\n\tid:ulong;\n\tname:string;\n\tgetName:string;\n}\n"), 0600))
+
+	var options = generator.Options{
+		InPath:        sourceFile,
+		ModelInfoFile: filepath.Join(dir, "objectbox-model.json"),
+		CodeGenerator: &cgenerator.CGenerator{LangVersion: 14, Accessors: true},
+		OutWriter: func(file string) (io.Writer, error) {
+			return ioutil.Discard, nil
+		},
+	}
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.True(t, strings.Contains(err.Error(), "accessor getName() of property Entity.name collides with property getName"))
+
+	// the same schema is fine with public members
+	options.CodeGenerator = &cgenerator.CGenerator{LangVersion: 14}
+	assert.NoErr(t, generator.Process(options))
+}
+
+func TestCleanOrphans(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var options = generator.Options{
+		InPath:        dir,
+		ModelInfoFile: generator.ModelInfoFile(dir),
+		CodeGenerator: &gogenerator.GoGenerator{},
+	}
+
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "a.go"), []byte("package test\n\ntype A struct {\n\tId uint64\n}\n"), 0600))
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "b.go"), []byte("package test\n\ntype B struct {\n\tId uint64\n}\n"), 0600))
+	assert.NoErr(t, generator.Process(options))
+
+	var exists = func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name))
+		return err == nil
+	}
+	assert.True(t, exists("a.obx.go"))
+	assert.True(t, exists("b.obx.go"))
+	assert.True(t, exists("objectbox-model.go"))
+
+	// only the outputs of the deleted source are removed
+	assert.NoErr(t, os.Remove(filepath.Join(dir, "b.go")))
+	assert.NoErr(t, generator.CleanOrphans(options))
+	assert.True(t, exists("a.go"))
+	assert.True(t, exists("a.obx.go"))
+	assert.True(t, !exists("b.obx.go"))
+	assert.True(t, exists("objectbox-model.go"))
+	assert.True(t, exists("objectbox-model.json"))
+}
+
+func TestOutWriterKeepsFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var options = generator.Options{
+		InPath:        dir,
+		ModelInfoFile: generator.ModelInfoFile(dir),
+		CodeGenerator: &gogenerator.GoGenerator{},
+	}
+
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "a.go"), []byte("package test\n\ntype A struct {\n\tId uint64\n}\n"), 0600))
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "b.go"), []byte("package test\n\ntype B struct {\n\tId uint64\n}\n"), 0600))
+	assert.NoErr(t, generator.Process(options))
+	assert.NoErr(t, os.Remove(filepath.Join(dir, "b.go")))
+
+	var exists = func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name))
+		return err == nil
+	}
+
+	var written []string
+	options.OutWriter = func(file string) (io.Writer, error) {
+		written = append(written, filepath.Base(file))
+		return ioutil.Discard, nil
+	}
+
+	// neither the implicit directory cleanup nor CleanOrphans may remove files when the output is redirected
+	assert.NoErr(t, generator.Process(options))
+	assert.Eq(t, []string{"a.obx.go", "objectbox-model.go"}, written)
+	assert.True(t, exists("a.obx.go"))
+	assert.True(t, exists("b.obx.go"))
+	assert.True(t, exists("objectbox-model.go"))
+
+	assert.NoErr(t, generator.CleanOrphans(options))
+	assert.True(t, exists("b.obx.go"))
+}
+
+func TestProfileFlags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode - builds the generator executable")
+	}
+
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "schema.fbs")
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("table Entity {\n\tid:ulong;\n\tname:string;\n}\n"), 0600))
+
+	var cpuProfile = filepath.Join(dir, "cpu.prof")
+	var memProfile = filepath.Join(dir, "mem.prof")
+
+	var cmd = exec.Command("go", "run", "../cmd/objectbox-generator", "-cpuprofile", cpuProfile, "-memprofile", memProfile, "-c", sourceFile)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generator failed: %s\n%s", err, output)
+	}
+
+	for _, file := range []string{cpuProfile, memProfile} {
+		info, err := os.Stat(file)
+		assert.NoErr(t, err)
+		assert.True(t, info.Size() > 0)
+	}
+
+	// profiling doesn't affect the generated output
+	_, err = os.Stat(filepath.Join(dir, "schema.obx.h"))
+	assert.NoErr(t, err)
+}
+
+func TestIdOnlyEntity(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "schema.fbs")
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("table IdOnly {\n\tid:ulong;\n}\ntable Normal {\n\tid:ulong;\n\tname:string;\n}\n"), 0600))
+
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	var options = generator.Options{
+		InPath:        sourceFile,
+		ModelInfoFile: filepath.Join(dir, "objectbox-model.json"),
+		CodeGenerator: &cgenerator.CGenerator{PlainC: true, LangVersion: -1},
+		OutWriter: func(file string) (io.Writer, error) {
+			return ioutil.Discard, nil
+		},
+	}
+
+	// a warning by default, naming only the ID-only entity
+	assert.NoErr(t, generator.Process(options))
+	assert.True(t, strings.Contains(logged.String(), "entity IdOnly has no properties besides the ID"))
+	assert.True(t, !strings.Contains(logged.String(), "Normal"))
+
+	// an error in the strict mode
+	options.Strict = true
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.Eq(t, "entity IdOnly has no properties besides the ID", err.Error())
+}
+
+func TestFbsOutRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var goDir = filepath.Join(dir, "go")
+	var fbsDir = filepath.Join(dir, "fbs")
+	assert.NoErr(t, os.Mkdir(goDir, 0700))
+	assert.NoErr(t, os.Mkdir(fbsDir, 0700))
+
+	var schemaFile = filepath.Join(fbsDir, "schema.fbs")
+	var generate = func(source string) {
+		assert.NoErr(t, ioutil.WriteFile(filepath.Join(goDir, "entities.go"), []byte(source), 0600))
+		assert.NoErr(t, generator.Process(generator.Options{
+			InPath:        goDir,
+			ModelInfoFile: generator.ModelInfoFile(goDir),
+			CodeGenerator: &gogenerator.GoGenerator{FbsOut: schemaFile},
+		}))
+	}
+
+	var source = `package test
+
+import "time"
+
+type Author struct {
+	Id   uint64
+	Name string ` + "`objectbox:\"unique\"`" + `
+}
+
+type Book struct {
+	Id        uint64    ` + "`objectbox:\"id(assignable)\"`" + `
+	Removed   int32
+	Title     string    ` + "`objectbox:\"index=value\"`" + `
+	Isbn      string    ` + "`objectbox:\"index=hash64 unique:replace\"`" + `
+	Pages     uint16    ` + "`objectbox:\"index\"`" + `
+	Published time.Time
+	Updated   int64     ` + "`objectbox:\"date-nano\"`" + `
+	Price     float64
+	Rating    float32
+	Flag      bool
+	Cover     []byte
+	Tags      []string
+	Embedding []float32
+	Author    *Author   ` + "`objectbox:\"link\"`" + `
+	Related   []*Author
+}
+`
+	generate(source)
+
+	// remove a property to leave a gap in the slots
+	generate(strings.Replace(source, "\tRemoved   int32\n", "", 1))
+
+	schema, err := ioutil.ReadFile(schemaFile)
+	assert.NoErr(t, err)
+	t.Logf("generated schema:\n%s", schema)
+	assert.True(t, strings.Contains(string(schema), "_unused1 : ubyte (id: 1, deprecated);"))
+
+	// the schema must be accepted by flatc
+	_, err = flatbuffersc.ParseSchemaFile(schemaFile)
+	assert.NoErr(t, err)
+
+	// generating from the schema with a copy of the model must produce the same model
+	goModel, err := ioutil.ReadFile(generator.ModelInfoFile(goDir))
+	assert.NoErr(t, err)
+	assert.NoErr(t, ioutil.WriteFile(generator.ModelInfoFile(fbsDir), goModel, 0600))
+	assert.NoErr(t, generator.Process(generator.Options{
+		InPath:        schemaFile,
+		ModelInfoFile: generator.ModelInfoFile(fbsDir),
+		CodeGenerator: &cgenerator.CGenerator{PlainC: true, LangVersion: -1},
+	}))
+
+	fbsModel, err := ioutil.ReadFile(generator.ModelInfoFile(fbsDir))
+	assert.NoErr(t, err)
+	assert.Eq(t, string(goModel), string(fbsModel))
+}
+
+func TestListTypes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode - builds the generator executable")
+	}
+
+	var listTypes = func(lang string) string {
+		var cmd = exec.Command("go", "run", "../cmd/objectbox-generator", "-"+lang, "-list-types")
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("generator failed: %s\n%s", err, output)
+		}
+		return string(output)
+	}
+
+	var output = listTypes("go")
+	var lines = strings.Split(output, "\n")
+	assert.True(t, strings.HasPrefix(lines[0], "SOURCE TYPE"))
+	assert.Eq(t, []string{"string", "String"}, strings.Fields(lines[1]))
+	assert.True(t, strings.Contains(output, "\nint64 "))
+	assert.True(t, strings.Contains(output, "\ntime.Time "))
+
+	output = listTypes("cpp")
+	assert.True(t, strings.Contains(output, "\nstring "))
+	assert.True(t, strings.Contains(output, "\n[ubyte] "))
+}
+
+func TestLineEndings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var fbsFile = filepath.Join(dir, "schema.fbs")
+	assert.NoErr(t, ioutil.WriteFile(fbsFile, []byte("table Entity {\n\tid:ulong;\n\tname:string;\n}\n"), 0600))
+	var goFile = filepath.Join(dir, "entity.go")
+	assert.NoErr(t, ioutil.WriteFile(goFile, []byte("package test\n\ntype Entity struct {\n\tId   uint64\n\tName string\n}\n"), 0600))
+
+	var generate = func(sourceFile string, codeGenerator generator.CodeGenerator, lineEndings string) map[string]string {
+		var outputs = make(map[string]*bytes.Buffer)
+		assert.NoErr(t, generator.Process(generator.Options{
+			InPath:        sourceFile,
+			ModelInfoFile: filepath.Join(dir, "objectbox-model.json"),
+			LineEndings:   lineEndings,
+			CodeGenerator: codeGenerator,
+			OutWriter: func(file string) (io.Writer, error) {
+				outputs[filepath.Base(file)] = &bytes.Buffer{}
+				return outputs[filepath.Base(file)], nil
+			},
+		}))
+
+		var result = make(map[string]string)
+		for file, output := range outputs {
+			result[file] = output.String()
+		}
+		assert.True(t, len(result) >= 2) // binding and model files
+		return result
+	}
+
+	var generators = map[string]generator.CodeGenerator{
+		fbsFile: &cgenerator.CGenerator{LangVersion: 14},
+		goFile:  &gogenerator.GoGenerator{},
+	}
+	for sourceFile, codeGenerator := range generators {
+		// LF by default
+		for file, source := range generate(sourceFile, codeGenerator, "") {
+			assert.True(t, strings.HasPrefix(source, "// Code generated by ObjectBox; DO NOT EDIT.\n"))
+			if strings.Contains(source, "\r") {
+				t.Errorf("%s contains CR characters", file)
+			}
+		}
+
+		// every line ends with CRLF, including the banner
+		for file, source := range generate(sourceFile, codeGenerator, generator.LineEndingsCRLF) {
+			assert.True(t, strings.HasPrefix(source, "// Code generated by ObjectBox; DO NOT EDIT.\r\n"))
+			if strings.Count(source, "\n") != strings.Count(source, "\r\n") {
+				t.Errorf("%s contains lines not ending with CRLF", file)
+			}
+		}
+	}
+
+	err = generator.Process(generator.Options{InPath: fbsFile, LineEndings: "cr", CodeGenerator: &cgenerator.CGenerator{LangVersion: 14}})
+	assert.Err(t, err)
+	assert.Eq(t, "invalid line endings 'cr', expecting lf or crlf", err.Error())
+}
+
+func TestStringIdFlags(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "entity.go")
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId string `objectbox:\"id\"`\n}\n\n"+
+		"type B struct {\n\tId string `objectbox:\"id(assignable)\"`\n}\n"), 0600))
+
+	var options = generator.Options{
+		InPath:        sourceFile,
+		ModelInfoFile: generator.ModelInfoFile(dir),
+		CodeGenerator: &gogenerator.GoGenerator{},
+		OutWriter: func(file string) (io.Writer, error) {
+			return ioutil.Discard, nil
+		},
+	}
+	assert.NoErr(t, generator.Process(options))
+
+	storedModel, err := model.LoadModelFromJSONFile(options.ModelInfoFile)
+	assert.NoErr(t, err)
+
+	// string IDs are stored as a plain (signed) long ID, there's no separate companion property
+	var expectedFlags = map[string]model.PropertyFlags{
+		"A": model.PropertyFlagId,
+		"B": model.PropertyFlagId | model.PropertyFlagIdSelfAssignable,
+	}
+	for name, flags := range expectedFlags {
+		entity, err := storedModel.FindEntityByName(name)
+		assert.NoErr(t, err)
+		assert.Eq(t, 1, len(entity.Properties))
+		assert.Eq(t, model.PropertyTypeLong, entity.Properties[0].Type)
+		assert.Eq(t, flags, entity.Properties[0].Flags)
+	}
+}
+
+func TestRemoveIndexedProperty(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "entity.go")
+	var options = generator.Options{
+		InPath:        sourceFile,
+		ModelInfoFile: generator.ModelInfoFile(dir),
+		CodeGenerator: &gogenerator.GoGenerator{},
+		OutWriter: func(file string) (io.Writer, error) {
+			return ioutil.Discard, nil
+		},
+	}
+
+	var loadEntity = func() (*model.ModelInfo, *model.Entity) {
+		storedModel, err := model.LoadModelFromJSONFile(options.ModelInfoFile)
+		assert.NoErr(t, err)
+		assert.NoErr(t, storedModel.Close())
+		entity, err := storedModel.FindEntityByName("A")
+		assert.NoErr(t, err)
+		return storedModel, entity
+	}
+
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tName string\n\tRemoved string `objectbox:\"index\"`\n}\n"), 0600))
+	assert.NoErr(t, generator.Process(options))
+
+	_, entity := loadEntity()
+	removed, err := entity.FindPropertyByName("Removed")
+	assert.NoErr(t, err)
+	assert.True(t, removed.IndexId != nil)
+	var indexId = *removed.IndexId
+
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tName string\n}\n"), 0600))
+	assert.NoErr(t, generator.Process(options))
+
+	storedModel, entity := loadEntity()
+	for _, property := range entity.Properties {
+		assert.True(t, property.IndexId == nil)
+	}
+	uid, err := indexId.GetUid()
+	assert.NoErr(t, err)
+	assert.Eq(t, []model.Uid{uid}, storedModel.RetiredIndexUids)
+
+	// a retired index must not be referenced by any property
+	name, err := entity.FindPropertyByName("Name")
+	assert.NoErr(t, err)
+	name.IndexId = &indexId
+	err = storedModel.Validate()
+	assert.Err(t, err)
+	assert.Eq(t, "index "+string(indexId)+" of property A.Name is listed in retiredIndexUids", err.Error())
+}
+
+func TestPropertyUidReset(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "entity.go")
+	var options = generator.Options{
+		InPath:        sourceFile,
+		ModelInfoFile: generator.ModelInfoFile(dir),
+		CodeGenerator: &gogenerator.GoGenerator{},
+		OutWriter: func(file string) (io.Writer, error) {
+			return ioutil.Discard, nil
+		},
+	}
+
+	var loadProperty = func() (*model.ModelInfo, *model.Entity, *model.Property) {
+		storedModel, err := model.LoadModelFromJSONFile(options.ModelInfoFile)
+		assert.NoErr(t, err)
+		assert.NoErr(t, storedModel.Close())
+		entity, err := storedModel.FindEntityByName("A")
+		assert.NoErr(t, err)
+		property, err := entity.FindPropertyByName("Value")
+		assert.NoErr(t, err)
+		return storedModel, entity, property
+	}
+
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tValue string\n}\n"), 0600))
+	assert.NoErr(t, generator.Process(options))
+	_, _, property := loadProperty()
+	oldId, oldUid, err := property.Id.Get()
+	assert.NoErr(t, err)
+
+	modelJson, err := ioutil.ReadFile(options.ModelInfoFile)
+	assert.NoErr(t, err)
+
+	// the reset is a one-time request: it fails, suggesting a new UID, and leaves the model untouched
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tValue string `objectbox:\"uid:reset\"`\n}\n"), 0600))
+	err = generator.Process(options)
+	assert.Err(t, err)
+	var match = regexp.MustCompile("replace the annotation with `objectbox:\"uid:([0-9]+)\"`").FindStringSubmatch(err.Error())
+	assert.Eq(t, 2, len(match))
+	assert.True(t, match[1] != strconv.FormatUint(uint64(oldUid), 10))
+	data, err := ioutil.ReadFile(options.ModelInfoFile)
+	assert.NoErr(t, err)
+	assert.Eq(t, string(modelJson), string(data))
+
+	// applying the suggested UID performs the reset
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tValue string `objectbox:\"uid:"+match[1]+"\"`\n}\n"), 0600))
+	assert.NoErr(t, generator.Process(options))
+	storedModel, entity, property := loadProperty()
+	newId, newUid, err := property.Id.Get()
+	assert.NoErr(t, err)
+	assert.Eq(t, match[1], strconv.FormatUint(uint64(newUid), 10))
+	assert.True(t, newId > oldId)
+	assert.Eq(t, property.Id, entity.LastPropertyId)
+	assert.NoErr(t, storedModel.Validate())
+
+	// the property must exist in the model in order to reset it
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tOther string `objectbox:\"uid:reset\"`\n}\n"), 0600))
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.True(t, strings.Contains(err.Error(), "uid:reset annotation can't be applied, the property isn't present in the persisted model"))
+}
+
+func TestMultipleLanguages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	// the same entity declared for each of the languages
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "entity.go"), []byte("package test\n\ntype A struct {\n\tId uint64\n\tName string\n}\n"), 0600))
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "entity.fbs"), []byte("table A {\n\tId: ulong;\n\tName: string;\n}\n"), 0600))
+
+	var outputs = make(map[string]*bytes.Buffer)
+	var options = generator.Options{
+		InPath:                   dir,
+		ModelInfoFile:            generator.ModelInfoFile(dir),
+		CodeGenerator:            &gogenerator.GoGenerator{},
+		AdditionalCodeGenerators: []generator.CodeGenerator{&cgenerator.CGenerator{PlainC: true, LangVersion: -1}},
+		OutWriter: func(file string) (io.Writer, error) {
+			outputs[filepath.Base(file)] = &bytes.Buffer{}
+			return outputs[filepath.Base(file)], nil
+		},
+	}
+	assert.NoErr(t, generator.Process(options))
+
+	for _, file := range []string{"entity.obx.go", "objectbox-model.go", "entity.obx.h", "objectbox-model.h"} {
+		assert.True(t, outputs[file] != nil)
+	}
+
+	storedModel, err := model.LoadModelFromJSONFile(generator.ModelInfoFile(dir))
+	assert.NoErr(t, err)
+	assert.NoErr(t, storedModel.Close())
+	assert.Eq(t, 1, len(storedModel.Entities))
+
+	// both languages use the IDs and UIDs of the shared model
+	var idsOf = func(idUid model.IdUid) string {
+		return strings.Replace(string(idUid), ":", ", ", 1)
+	}
+	var entity = storedModel.Entities[0]
+	assert.Eq(t, 2, len(entity.Properties))
+	var goBinding, cModel = outputs["entity.obx.go"].String(), outputs["objectbox-model.h"].String()
+	assert.True(t, strings.Contains(goBinding, `model.Entity("A", `+idsOf(entity.Id)+`)`))
+	assert.True(t, strings.Contains(cModel, `obx_model_entity(model, "A", `+idsOf(entity.Id)+`);`))
+	for _, property := range entity.Properties {
+		assert.True(t, strings.Contains(goBinding, `model.Property("`+property.Name+`", 6, `+idsOf(property.Id)+`)`) ||
+			strings.Contains(goBinding, `model.Property("`+property.Name+`", 9, `+idsOf(property.Id)+`)`))
+		assert.True(t, strings.Contains(cModel, `obx_model_property(model, "`+property.Name+`", OBXPropertyType_Long, `+idsOf(property.Id)+`);`) ||
+			strings.Contains(cModel, `obx_model_property(model, "`+property.Name+`", OBXPropertyType_String, `+idsOf(property.Id)+`);`))
+	}
+}
+
+func TestSummaryJson(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode - builds the generator executable")
+	}
+
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "schema.fbs")
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("table Author {\n\tid:ulong;\n\t/// objectbox:index\n\tname:string;\n}\n"), 0600))
+
+	var summaryFile = filepath.Join(dir, "summary.json")
+	var cmd = exec.Command("go", "run", "../cmd/objectbox-generator", "-summary-json", summaryFile, "-c", sourceFile)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generator failed: %s\n%s", err, output)
+	}
+
+	data, err := ioutil.ReadFile(summaryFile)
+	assert.NoErr(t, err)
+
+	var summary struct {
+		Entities []struct {
+			Name       string
+			Id         uint32
+			Uid        string
+			Flags      []string
+			Properties []struct {
+				Name  string
+				Id    uint32
+				Uid   string
+				Type  string
+				Flags []string
+			}
+			Relations []interface{}
+		}
+	}
+	assert.NoErr(t, json.Unmarshal(data, &summary))
+
+	storedModel, err := model.LoadModelFromJSONFile(generator.ModelInfoFile(dir))
+	assert.NoErr(t, err)
+	assert.NoErr(t, storedModel.Close())
+
+	// the summary describes the same schema as the model file, but it's a distinct file
+	assert.Eq(t, 1, len(summary.Entities))
+	var entity = summary.Entities[0]
+	assert.Eq(t, "Author", entity.Name)
+	assert.Eq(t, string(storedModel.Entities[0].Id), fmt.Sprintf("%d:%s", entity.Id, entity.Uid))
+	assert.Eq(t, []string{}, entity.Flags)
+	assert.Eq(t, 0, len(entity.Relations))
+	assert.Eq(t, 2, len(entity.Properties))
+	assert.Eq(t, "id", entity.Properties[0].Name)
+	assert.Eq(t, "Long", entity.Properties[0].Type)
+	assert.Eq(t, []string{"Id"}, entity.Properties[0].Flags)
+	assert.Eq(t, "name", entity.Properties[1].Name)
+	assert.Eq(t, "String", entity.Properties[1].Type)
+	assert.Eq(t, []string{"IndexHash"}, entity.Properties[1].Flags)
+	assert.Eq(t, string(storedModel.Entities[0].Properties[1].Id), fmt.Sprintf("%d:%s", entity.Properties[1].Id, entity.Properties[1].Uid))
+}
+
+func TestDryRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode - builds the generator executable")
+	}
+
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "a.fbs"), []byte("table A {\n\tid:ulong;\n\tname:string;\n}\n"), 0600))
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "b.fbs"), []byte("table B {\n\tid:ulong;\n\tname:string;\n}\n"), 0600))
+
+	var run = func(args ...string) []string {
+		var cmd = exec.Command("go", append([]string{"run", "../cmd/objectbox-generator", "-c", "-model", generator.ModelInfoFile(dir)}, args...)...)
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("generator failed: %s\n%s", err, output)
+		}
+		var lines []string
+		for _, line := range strings.Split(string(output), "\n") {
+			if strings.HasPrefix(line, "Would ") {
+				lines = append(lines, strings.Replace(line, dir+string(os.PathSeparator), "", -1))
+			}
+		}
+		return lines
+	}
+
+	var listDir = func() []string {
+		files, err := ioutil.ReadDir(dir)
+		assert.NoErr(t, err)
+		var names []string
+		for _, file := range files {
+			names = append(names, file.Name())
+		}
+		return names
+	}
+
+	// nothing generated yet
+	assert.Eq(t, []string{
+		"Would create a.obx.h",
+		"Would create b.obx.h",
+		"Would create objectbox-model.json",
+		"Would create objectbox-model.h",
+	}, run("-dry-run", dir))
+	assert.Eq(t, []string{"a.fbs", "b.fbs"}, listDir())
+
+	// nothing changes if already generated
+	assert.Eq(t, 0, len(run(dir)))
+	assert.Eq(t, 0, len(run("-dry-run", dir)))
+
+	// removing an entity
+	assert.NoErr(t, os.Remove(filepath.Join(dir, "b.fbs")))
+	modelBefore, err := ioutil.ReadFile(generator.ModelInfoFile(dir))
+	assert.NoErr(t, err)
+	var lines = run("-dry-run", dir)
+	assert.Eq(t, 4, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "Would remove missing entity B "))
+	assert.Eq(t, []string{
+		"Would overwrite objectbox-model.json",
+		"Would overwrite objectbox-model.h",
+		"Would remove b.obx.h",
+	}, lines[1:])
+	modelAfter, err := ioutil.ReadFile(generator.ModelInfoFile(dir))
+	assert.NoErr(t, err)
+	assert.Eq(t, string(modelBefore), string(modelAfter))
+
+	// clean
+	var files = listDir()
+	assert.Eq(t, []string{
+		"Would remove a.obx.h",
+		"Would remove b.obx.h",
+		"Would remove objectbox-model.h",
+	}, run("-dry-run", "clean", dir))
+	assert.Eq(t, files, listDir())
+}
+
+func TestCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "entity.go")
+	var bindingFile = filepath.Join(dir, "entity.obx.go")
+	var options = generator.Options{
+		InPath:        sourceFile,
+		CodeGenerator: &gogenerator.GoGenerator{},
+	}
+
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tName string\n}\n"), 0600))
+	assert.NoErr(t, generator.Process(options))
+
+	// an up-to-date tree passes the check
+	options.Check = true
+	assert.NoErr(t, generator.Process(options))
+
+	// a changed source is reported, listing the stale files, but nothing is written
+	binding, err := ioutil.ReadFile(bindingFile)
+	assert.NoErr(t, err)
+	modelJson, err := ioutil.ReadFile(generator.ModelInfoFile(dir))
+	assert.NoErr(t, err)
+
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tName string\n\tAge int\n}\n"), 0600))
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.Eq(t, "generated files are not up to date, run the generator to update them:\n"+
+		"  would overwrite "+bindingFile+"\n"+
+		"  would overwrite "+generator.ModelInfoFile(dir), err.Error())
+
+	data, err := ioutil.ReadFile(bindingFile)
+	assert.NoErr(t, err)
+	assert.Eq(t, string(binding), string(data))
+	data, err = ioutil.ReadFile(generator.ModelInfoFile(dir))
+	assert.NoErr(t, err)
+	assert.Eq(t, string(modelJson), string(data))
+
+	if testing.Short() {
+		t.Log("skipping the command exit code check in short mode - builds the generator executable")
+		return
+	}
+
+	var cmd = exec.Command("go", "run", "../cmd/objectbox-generator", "-check", "-go", sourceFile)
+	output, err := cmd.CombinedOutput()
+	assert.Err(t, err)
+	assert.True(t, strings.Contains(string(output), "would overwrite "+bindingFile))
+
+	assert.NoErr(t, generator.Process(generator.Options{InPath: sourceFile, CodeGenerator: &gogenerator.GoGenerator{}}))
+	cmd = exec.Command("go", "run", "../cmd/objectbox-generator", "-check", "-go", sourceFile)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("check failed on an up-to-date tree: %s\n%s", err, output)
+	}
+}
+
+func TestGoPackageName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "entity.go")
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tName string\n}\n"), 0600))
+
+	var generate = func(pkg string) (map[string]*bytes.Buffer, error) {
+		var outputs = make(map[string]*bytes.Buffer)
+		var err = generator.Process(generator.Options{
+			InPath:        sourceFile,
+			ModelInfoFile: generator.ModelInfoFile(dir),
+			CodeGenerator: &gogenerator.GoGenerator{Package: pkg},
+			OutWriter: func(file string) (io.Writer, error) {
+				outputs[filepath.Base(file)] = &bytes.Buffer{}
+				return outputs[filepath.Base(file)], nil
+			},
+		})
+		return outputs, err
+	}
+
+	outputs, err := generate("models")
+	assert.NoErr(t, err)
+	assert.True(t, strings.Contains(outputs["entity.obx.go"].String(), "\npackage models\n"))
+	assert.True(t, strings.Contains(outputs["objectbox-model.go"].String(), "\npackage models\n"))
+
+	for _, pkg := range []string{"1models", "my-models", "func", "_"} {
+		_, err = generate(pkg)
+		assert.Err(t, err)
+		assert.Eq(t, "invalid package name '"+pkg+"', expecting a Go identifier", err.Error())
+	}
+}
+
+func TestCustomModelFileName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "entity.go"), []byte("package test\n\ntype A struct {\n\tId uint64\n\tName string\n}\n"), 0600))
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "schema.fbs"), []byte("table B {\n\tid:ulong;\n\tname:string;\n}\n"), 0600))
+
+	var exists = func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name))
+		return err == nil
+	}
+
+	var options = generator.Options{
+		InPath:                   dir,
+		ModelInfoFile:            filepath.Join(dir, "orders-model.json"),
+		CodeGenerator:            &gogenerator.GoGenerator{},
+		AdditionalCodeGenerators: []generator.CodeGenerator{&cgenerator.CGenerator{PlainC: true, LangVersion: -1}},
+	}
+
+	// running twice: the generated orders-model.go must not be taken for a source file on the second run
+	for i := 0; i < 2; i++ {
+		assert.NoErr(t, generator.Process(options))
+		for _, file := range []string{"orders-model.json", "orders-model.go", "orders-model.h", "entity.obx.go", "schema.obx.h"} {
+			assert.True(t, exists(file))
+		}
+		for _, file := range []string{"objectbox-model.json", "objectbox-model.go", "objectbox-model.h", "orders-model.obx.go"} {
+			assert.True(t, !exists(file))
+		}
+	}
+
+	assert.NoErr(t, generator.CleanWithOptions(options))
+	for _, file := range []string{"orders-model.go", "orders-model.h", "entity.obx.go", "schema.obx.h"} {
+		assert.True(t, !exists(file))
+	}
+	assert.True(t, exists("orders-model.json"))
+	assert.True(t, exists("entity.go"))
+
+	// a model file named the same as a source file must not overwrite it
+	options.ModelInfoFile = filepath.Join(dir, "entity.json")
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.True(t, strings.Contains(err.Error(), "already exists and wasn't generated"))
+	assert.True(t, !exists("entity.json"))
+}
+
+func TestVerboseLogger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "a.fbs"), []byte("table A {\n\tid:ulong;\n\tname:string;\n}\n"), 0600))
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "b.fbs"), []byte("table B {\n\tid:ulong;\n}\n"), 0600))
+
+	var options = generator.Options{
+		InPath:        dir,
+		ModelInfoFile: generator.ModelInfoFile(dir),
+		CodeGenerator: &cgenerator.CGenerator{PlainC: true, LangVersion: -1},
+	}
+	assert.NoErr(t, generator.Process(options))
+
+	storedModel, err := model.LoadModelFromJSONFile(options.ModelInfoFile)
+	assert.NoErr(t, err)
+	assert.NoErr(t, storedModel.Close())
+	var entityB = storedModel.Entities[1]
+
+	// replace B with a new entity C
+	assert.NoErr(t, os.Remove(filepath.Join(dir, "b.fbs")))
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "c.fbs"), []byte("table C {\n\tid:ulong;\n\t/// objectbox:index\n\tcode:int;\n}\n"), 0600))
+
+	var output bytes.Buffer
+	options.Logger = log.New(&output, "", 0)
+	assert.NoErr(t, generator.Process(options))
+
+	storedModel, err = model.LoadModelFromJSONFile(options.ModelInfoFile)
+	assert.NoErr(t, err)
+	assert.NoErr(t, storedModel.Close())
+	var entityA, entityC = storedModel.Entities[0], storedModel.Entities[1]
+
+	var expected = []string{
+		"Writing " + filepath.Join(dir, "a.obx.h"),
+		"Writing " + filepath.Join(dir, "c.obx.h"),
+		"Writing " + options.ModelInfoFile,
+		"Writing " + filepath.Join(dir, "objectbox-model.h"),
+		"Entity A " + string(entityA.Id) + ": 2 properties, 0 relations",
+		"Entity C " + string(entityC.Id) + ": 2 properties, 0 relations",
+		"Assigned new entity C ID/UID " + string(entityC.Id),
+		"Assigned new property C.id ID/UID " + string(entityC.Properties[0].Id),
+		"Assigned new property C.code ID/UID " + string(entityC.Properties[1].Id),
+		"Assigned new index for property C.code ID/UID " + string(*entityC.Properties[1].IndexId),
+		"Removed entity B ID/UID " + string(entityB.Id),
+		"Removed property B.id ID/UID " + string(entityB.Properties[0].Id),
+	}
+	assert.Eq(t, strings.Join(expected, "\n")+"\n", output.String())
+}
+
+func TestCleanKeepsLookAlikes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var exists = func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name))
+		return err == nil
+	}
+
+	var gen = &cgenerator.CGenerator{PlainC: true, LangVersion: -1}
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "a.fbs"), []byte("table A {\n\tid:ulong;\n\tname:string;\n}\n"), 0600))
+	assert.NoErr(t, generator.Process(generator.Options{InPath: dir, ModelInfoFile: generator.ModelInfoFile(dir), CodeGenerator: gen}))
+	assert.True(t, exists("a.obx.h"))
+
+	// a hand-written file matching the generated file naming
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "b.obx.h"), []byte("// my own header\n#pragma once\n"), 0600))
+
+	assert.NoErr(t, generator.Clean(gen, dir))
+	assert.True(t, !exists("a.obx.h"))
+	assert.True(t, !exists("objectbox-model.h"))
+	assert.True(t, exists("b.obx.h"))
+
+	assert.NoErr(t, generator.CleanWithOptions(generator.Options{InPath: dir, CodeGenerator: gen, Force: true}))
+	assert.True(t, !exists("b.obx.h"))
+	assert.True(t, exists("a.fbs"))
+}
+
+func TestCleanLegacyBanner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	// the banner of Go bindings generated by previous versions has a trailing space
+	var legacy = "// Code generated by ObjectBox; DO NOT EDIT. \n// Learn more about defining entities and generating this file - visit https://golang.objectbox.io/entity-annotations\n\npackage test\n"
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "gone.obx.go"), []byte(legacy), 0600))
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "crlf.obx.go"), []byte(strings.Replace(legacy, "\n", "\r\n", -1)), 0600))
+
+	assert.NoErr(t, generator.CleanWithOptions(generator.Options{InPath: dir, CodeGenerator: &gogenerator.GoGenerator{}}))
+	for _, name := range []string{"gone.obx.go", "crlf.obx.go"} {
+		_, err = os.Stat(filepath.Join(dir, name))
+		assert.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestRecursiveSharedModel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var write = func(file, content string) {
+		assert.NoErr(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, file)), 0700))
+		assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, file), []byte(content), 0600))
+	}
+	var exists = func(file string) bool {
+		_, err := os.Stat(filepath.Join(dir, file))
+		return err == nil
+	}
+
+	write("orders/order.fbs", "table Order {\n\tid:ulong;\n\tdate:long;\n}\n")
+	write("customers/customer.fbs", "table Customer {\n\tid:ulong;\n\tname:string;\n}\n")
+
+	var options = generator.Options{
+		InPath:        dir + "/...",
+		ModelInfoFile: filepath.Join(dir, "schema", "model.json"),
+		CodeGenerator: &cgenerator.CGenerator{PlainC: true, LangVersion: -1},
+	}
+	assert.NoErr(t, os.MkdirAll(filepath.Join(dir, "schema"), 0700))
+	assert.NoErr(t, generator.Process(options))
+
+	// a single model with entities from both directories, bindings are generated next to their sources
+	for _, file := range []string{"orders/order.obx.h", "customers/customer.obx.h", "schema/model.json", "schema/model.h"} {
+		assert.True(t, exists(file))
+	}
+	storedModel, err := model.LoadModelFromJSONFile(options.ModelInfoFile)
+	assert.NoErr(t, err)
+	assert.NoErr(t, storedModel.Close())
+	assert.Eq(t, 2, len(storedModel.Entities))
+	assert.Eq(t, "Customer", storedModel.Entities[0].Name)
+	assert.Eq(t, "Order", storedModel.Entities[1].Name)
+
+	// entity names must be unique across all the directories
+	write("archive/order.fbs", "table order {\n\tid:ulong;\n\tdate:long;\n}\n")
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.Eq(t, "can't merge model information: entity Order declared in "+filepath.Join(dir, "orders", "order.fbs")+" is already declared in "+filepath.Join(dir, "archive", "order.fbs")+
+		"; all source files share a single model so entity names must be unique (case insensitive)", err.Error())
+	assert.NoErr(t, os.RemoveAll(filepath.Join(dir, "archive")))
+
+	// Go entities from different packages can't be registered by a single model file
+	write("orders/order.go", "package orders\n\ntype GoOrder struct {\n\tId uint64\n\tDate int64\n}\n")
+	write("customers/customer.go", "package customers\n\ntype GoCustomer struct {\n\tId uint64\n\tName string\n}\n")
+	options.ModelInfoFile = filepath.Join(dir, "schema", "go-model.json")
+	options.CodeGenerator = &gogenerator.GoGenerator{}
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.True(t, strings.Contains(err.Error(), "can't be shared by entities in multiple Go packages"))
+
+	// a run failing after some bindings have been written doesn't affect the next one using the same generator
+	write("orders/zinvalid.go", "package orders\n\ntype Invalid struct {\n\tName string\n}\n")
+	options.InPath = filepath.Join(dir, "orders")
+	options.ModelInfoFile = generator.ModelInfoFile(options.InPath)
+	assert.Err(t, generator.Process(options))
+	assert.NoErr(t, os.Remove(filepath.Join(dir, "orders", "zinvalid.go")))
+	options.InPath = filepath.Join(dir, "customers")
+	options.ModelInfoFile = generator.ModelInfoFile(options.InPath)
+	assert.NoErr(t, generator.Process(options))
+}
+
+func TestDuplicateEntity(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var options = generator.Options{
+		InPath:        dir,
+		ModelInfoFile: generator.ModelInfoFile(dir),
+		CodeGenerator: &gogenerator.GoGenerator{},
+		OutWriter: func(file string) (io.Writer, error) {
+			return ioutil.Discard, nil
+		},
+	}
+
+	// the same name in two files
+	var fileA, fileB = filepath.Join(dir, "a.go"), filepath.Join(dir, "b.go")
+	assert.NoErr(t, ioutil.WriteFile(fileA, []byte("package test\n\ntype User struct {\n\tId uint64\n\tName string\n}\n"), 0600))
+	assert.NoErr(t, ioutil.WriteFile(fileB, []byte("package test\n\ntype User struct {\n\tId uint64\n\tEmail string\n}\n"), 0600))
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.Eq(t, "can't merge model information: entity User declared in "+fileB+" is already declared in "+fileA+
+		"; all source files share a single model so entity names must be unique (case insensitive)", err.Error())
+
+	// a different name but the same UID, i.e. a rename of the same entity
+	assert.NoErr(t, os.Remove(fileB))
+	assert.NoErr(t, generator.Process(options))
+	storedModel, err := model.LoadModelFromJSONFile(options.ModelInfoFile)
+	assert.NoErr(t, err)
+	assert.NoErr(t, storedModel.Close())
+	var userId = storedModel.Entities[0].Id
+	userUid, err := userId.GetUid()
+	assert.NoErr(t, err)
+
+	assert.NoErr(t, ioutil.WriteFile(fileB, []byte(fmt.Sprintf("package test\n\n// `objectbox:\"uid:%d\"`\ntype Account struct {\n\tId uint64\n\tEmail string\n}\n", userUid)), 0600))
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.Eq(t, "can't merge model information: entity Account declared in "+fileB+" maps to the same model entity "+string(userId)+
+		" as entity User declared in "+fileA, err.Error())
+}
+
+func TestCustomSuffix(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var exists = func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name))
+		return err == nil
+	}
+
+	var gen = &cgenerator.CGenerator{PlainC: false, LangVersion: 14}
+	assert.NoErr(t, ioutil.WriteFile(filepath.Join(dir, "a.fbs"), []byte("table A {\n\tid:ulong;\n\tname:string;\n}\n"), 0600))
+
+	var options = generator.Options{InPath: dir, ModelInfoFile: generator.ModelInfoFile(dir), CodeGenerator: gen, Suffix: ".gen"}
+	assert.NoErr(t, generator.Process(options))
+	assert.True(t, exists("a.gen.hpp"))
+	assert.True(t, exists("a.gen.cpp"))
+	assert.True(t, !exists("a.obx.hpp"))
+	assert.True(t, gen.IsGeneratedFile("a.gen.hpp", options))
+	assert.True(t, !gen.IsGeneratedFile("a.obx.hpp", options))
+
+	// regenerating mustn't treat the previously generated files as sources or leave them behind
+	assert.NoErr(t, generator.Process(options))
+	assert.True(t, exists("a.gen.hpp"))
+
+	// cleaning with the default suffix doesn't recognize the files
+	assert.NoErr(t, generator.CleanWithOptions(generator.Options{InPath: dir, CodeGenerator: gen}))
+	assert.True(t, exists("a.gen.hpp"))
+
+	assert.NoErr(t, generator.CleanWithOptions(generator.Options{InPath: dir, CodeGenerator: gen, Suffix: ".gen"}))
+	assert.True(t, !exists("a.gen.hpp"))
+	assert.True(t, !exists("a.gen.cpp"))
+	assert.True(t, exists("a.fbs"))
+
+	options.Suffix = "gen/"
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.Eq(t, "invalid suffix 'gen/', expecting a dot followed by letters, digits, '_' or '-', e.g. '.gen'", err.Error())
+}
+
+func TestGoBuildTag(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceFile = filepath.Join(dir, "entity.go")
+	assert.NoErr(t, ioutil.WriteFile(sourceFile, []byte("package test\n\ntype A struct {\n\tId uint64\n\tName string\n}\n"), 0600))
+
+	var options = generator.Options{
+		InPath:        sourceFile,
+		ModelInfoFile: generator.ModelInfoFile(dir),
+		CodeGenerator: &gogenerator.GoGenerator{BuildTag: "objectbox"},
+	}
+	assert.NoErr(t, generator.Process(options))
+
+	// the go tool only recognizes the constraint if it's placed correctly, i.e. before the package clause and followed
+	// by an empty line, thus the generated files must be excluded from a build without the tag
+	var withoutTag = build.Default
+	withoutTag.BuildTags = nil
+	var withTag = build.Default
+	withTag.BuildTags = []string{"objectbox"}
+
+	for _, file := range []string{"entity.obx.go", "objectbox-model.go"} {
+		_, err := parser.ParseFile(token.NewFileSet(), filepath.Join(dir, file), nil, parser.ParseComments)
+		assert.NoErr(t, err)
+
+		match, err := withoutTag.MatchFile(dir, file)
+		assert.NoErr(t, err)
+		assert.True(t, !match)
+
+		match, err = withTag.MatchFile(dir, file)
+		assert.NoErr(t, err)
+		assert.True(t, match)
+	}
+
+	match, err := withoutTag.MatchFile(dir, "entity.go")
+	assert.NoErr(t, err)
+	assert.True(t, match)
+
+	options.CodeGenerator = &gogenerator.GoGenerator{BuildTag: "objectbox\npackage x"}
+	err = generator.Process(options)
+	assert.Err(t, err)
+	assert.Eq(t, "invalid build tag 'objectbox\npackage x', expecting a single-line build constraint expression, e.g. 'objectbox'", err.Error())
+}
@@ -25,6 +25,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/objectbox/objectbox-generator/v4/internal/generator"
@@ -34,6 +35,9 @@ import (
 	"github.com/objectbox/objectbox-generator/v4/test/cmake"
 )
 
+// cGeneratorArgsRegexp matches generator options given in the source schema, e.g. `// objectbox-generator -accessors`
+var cGeneratorArgsRegexp = regexp.MustCompile("// objectbox-generator (.+)[\n|\r]")
+
 type cTestHelper struct {
 	cpp        bool
 	canCompile bool
@@ -52,8 +56,27 @@ func (h *cTestHelper) init(t *testing.T, conf testSpec) {
 }
 
 func (h cTestHelper) generatorFor(t *testing.T, conf testSpec, sourceFile string, genDir string) generator.CodeGenerator {
+	source, err := ioutil.ReadFile(sourceFile)
+	assert.NoErr(t, err)
+
 	// make a copy of the default generator
 	var gen = *conf.generator.(*cgenerator.CGenerator)
+
+	if match := cGeneratorArgsRegexp.FindSubmatch(source); len(match) > 1 {
+		var args = argsToMap(string(match[1]))
+		for name := range args {
+			switch name {
+			case "accessors":
+				gen.Accessors = true
+			case "equality":
+				gen.Equality = true
+			case "skip-deprecated":
+				gen.SkipDeprecated = true
+			default:
+				t.Fatalf("unknown option '%s'", name)
+			}
+		}
+	}
 	return &gen
 }
 
@@ -114,7 +137,7 @@ func (h cTestHelper) build(t *testing.T, conf testSpec, dir string, expectedErro
 		files, err := ioutil.ReadDir(includeDir)
 		assert.NoErr(t, err)
 		for _, file := range files {
-			if conf.generator.IsGeneratedFile(file.Name()) {
+			if conf.generator.IsGeneratedFile(file.Name(), generator.Options{}) {
 				mainSrc = mainSrc + "#include \"" + file.Name() + "\"\n"
 			}
 		}
@@ -22,12 +22,15 @@ package comparison
 
 import (
 	"errors"
+	"flag"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/objectbox/objectbox-generator/v4/internal/generator"
@@ -38,6 +41,16 @@ import (
 // this containing module name - used for test case modules
 const goModuleName = "github.com/objectbox/objectbox-generator"
 
+// the generated code can only be compiled with objectbox-go and the ObjectBox native library available;
+// it's compiled (and its tests run) by default, unless those aren't available, see goBuildAvailable()
+var compileGo = flag.Bool("compile", true,
+	"Compile the generated Go code and run its tests if objectbox-go and the ObjectBox native library are available")
+
+var goBuildAvailability struct {
+	once sync.Once
+	err  error
+}
+
 var goGeneratorArgsRegexp = regexp.MustCompile("//go:generate go run github.com/objectbox/objectbox-go/cmd/objectbox-gogen (.+)[\n|\r]")
 
 type goTestHelper struct{}
@@ -54,11 +67,26 @@ func (h goTestHelper) generatorFor(t *testing.T, conf testSpec, sourceFile strin
 	if match := goGeneratorArgsRegexp.FindSubmatch(source); len(match) > 1 {
 		var args = argsToMap(string(match[1]))
 		for name, value := range args {
-			_ = value // get rid of the testHelper warning until we start using some options with values
-
 			switch name {
+			case "build-tag":
+				gen.BuildTag = value
 			case "byValue":
 				gen.ByValue = true
+			case "clone":
+				gen.Clone = true
+			case "equal":
+				gen.Equal = true
+			case "observers":
+				gen.Observers = true
+			case "stringer":
+				gen.Stringer = true
+			case "package":
+				gen.Package = value
+			case "relation-load":
+				if value != "eager" && value != "lazy" {
+					t.Fatalf("invalid relation-load value '%s'", value)
+				}
+				gen.LazyRelations = value == "lazy"
 			default:
 				t.Fatalf("unknown option '%s'", name)
 			}
@@ -70,18 +98,21 @@ func (h goTestHelper) generatorFor(t *testing.T, conf testSpec, sourceFile strin
 func argsToMap(args string) map[string]string {
 	var result = map[string]string{}
 
-	for _, arg := range strings.Split(strings.TrimSpace(args), "-") {
-		arg = strings.TrimSpace(arg)
-
-		if len(arg) == 0 {
-			continue
-		}
-
-		var pair = strings.Split(arg, " ")
-		if len(pair) == 1 {
-			result[pair[0]] = ""
-		} else {
-			result[pair[0]] = pair[1]
+	// supports `-name`, `-name value` and `-name=value`
+	var name string
+	for _, arg := range strings.Fields(args) {
+		if strings.HasPrefix(arg, "-") {
+			var pair = strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)
+			name = pair[0]
+			if len(pair) == 1 {
+				result[name] = ""
+			} else {
+				result[name] = pair[1]
+				name = ""
+			}
+		} else if len(name) > 0 {
+			result[name] = arg
+			name = ""
 		}
 	}
 
@@ -108,7 +139,12 @@ func (goTestHelper) prepareTempDir(t *testing.T, conf testSpec, srcDir, tempDir,
 }
 
 func (goTestHelper) build(t *testing.T, conf testSpec, dir string, expectedError error, errorTransformer func(err error) error) {
-	t.Skip("Go test compilation temporarily disabled due to local objectbox lib linking issues")
+	if !*compileGo {
+		t.Skip("Go test compilation is disabled by -compile=false")
+	}
+	if err := goBuildAvailable(); err != nil {
+		t.Skipf("Go test compilation is not available: %s", err)
+	}
 
 	stdOut, stdErr, err := gobuild(dir)
 	if err == nil && expectedError == nil {
@@ -124,11 +160,56 @@ func (goTestHelper) build(t *testing.T, conf testSpec, dir string, expectedError
 }
 
 func gobuild(path string) (stdOut []byte, stdErr []byte, err error) {
-	var cmd = exec.Command("go", "build")
+	// the test module only declares its name, resolve the imported objectbox-go first
+	var cmd = exec.Command("go", "mod", "tidy")
 	cmd.Dir = path
-	stdOut, err = cmd.Output()
+	if stdOut, err = cmd.Output(); err == nil {
+		cmd = exec.Command("go", "build")
+		cmd.Dir = path
+		stdOut, err = cmd.Output()
+	}
+
+	// run the tests using the generated code, if the test case has any
+	if err == nil {
+		var tests []string
+		if tests, err = filepath.Glob(filepath.Join(path, "*_test.go")); err == nil && len(tests) > 0 {
+			cmd = exec.Command("go", "test", "-count=1", ".")
+			cmd.Dir = path
+			stdOut, err = cmd.Output()
+		}
+	}
 	if ee, ok := err.(*exec.ExitError); ok {
 		stdErr = ee.Stderr
 	}
 	return
 }
+
+// goBuildAvailable checks (once) whether a program using objectbox-go can be built in this environment, i.e. whether
+// objectbox-go can be downloaded (or is in the module cache) and the ObjectBox native library is installed.
+func goBuildAvailable() error {
+	goBuildAvailability.once.Do(func() {
+		dir, err := ioutil.TempDir("", "objectbox-generator-probe")
+		if err != nil {
+			goBuildAvailability.err = err
+			return
+		}
+		defer os.RemoveAll(dir)
+
+		var files = map[string]string{
+			"go.mod": "module " + goModuleName + "/test/comparison/probe\n",
+			"main.go": "package main\n\nimport \"github.com/objectbox/objectbox-go/objectbox\"\n\n" +
+				"func main() { _ = objectbox.NewBuilder }\n",
+		}
+		for name, content := range files {
+			if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+				goBuildAvailability.err = err
+				return
+			}
+		}
+
+		if _, stdErr, err := gobuild(dir); err != nil {
+			goBuildAvailability.err = errors.New(strings.TrimSpace(err.Error() + ": " + string(stdErr)))
+		}
+	})
+	return goBuildAvailability.err
+}
@@ -216,9 +216,10 @@ func generateAllFiles(t *testing.T, overwriteExpected bool, conf testSpec, srcDi
 	assert.True(t, len(inputFiles) > 0)
 
 	for _, sourceFile := range inputFiles {
-		// skip generated files & "expected results" files
-		if conf.generator.IsGeneratedFile(sourceFile) ||
+		// skip generated files, tests run on the generated code & "expected results" files
+		if conf.generator.IsGeneratedFile(sourceFile, generator.Options{}) ||
 			strings.HasSuffix(sourceFile, ".skip"+conf.sourceExt) ||
+			strings.HasSuffix(sourceFile, "_test"+conf.sourceExt) ||
 			strings.HasSuffix(sourceFile, "expected") ||
 			strings.HasSuffix(sourceFile, "initial") {
 			continue
@@ -0,0 +1,8 @@
+package object
+
+//go:generate go run github.com/objectbox/objectbox-go/cmd/objectbox-gogen -build-tag objectbox
+
+type Entity struct {
+	Id   uint64
+	Name string
+}
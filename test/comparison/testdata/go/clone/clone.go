@@ -0,0 +1,20 @@
+package object
+
+//go:generate go run github.com/objectbox/objectbox-go/cmd/objectbox-gogen -clone
+
+type Cloned struct {
+	Id       uint64
+	Name     string
+	Bytes    []byte
+	Strings  []string
+	Floats   []float32
+	Nullable *int64
+	Inner    *Inner `objectbox:"inline"`
+	Group    *Group `objectbox:"link"`
+	Groups   []*Group
+}
+
+type Group struct {
+	Id   uint64
+	Name string
+}
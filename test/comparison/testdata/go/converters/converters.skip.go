@@ -26,3 +26,35 @@ func runeIdToEntityProperty(dbValue uint64) (rune, error) {
 func runeIdToDatabaseValue(goValue rune) (uint64, error) {
 	return uint64(goValue), nil
 }
+
+// Color is stored as a "#rrggbb" string in the database
+type Color struct {
+	R, G, B uint8
+}
+
+// Priority is stored as an int in the database
+type Priority struct {
+	Level int
+	Label string
+}
+
+func colorStringToEntityProperty(dbValue string) (Color, error) {
+	var color Color
+	if len(dbValue) == 0 {
+		return color, nil
+	}
+	_, err := fmt.Sscanf(dbValue, "#%02x%02x%02x", &color.R, &color.G, &color.B)
+	return color, err
+}
+
+func colorStringToDatabaseValue(goValue Color) (string, error) {
+	return fmt.Sprintf("#%02x%02x%02x", goValue.R, goValue.G, goValue.B), nil
+}
+
+func priorityIntToEntityProperty(dbValue int32) (Priority, error) {
+	return Priority{Level: int(dbValue)}, nil
+}
+
+func priorityIntToDatabaseValue(goValue Priority) (int32, error) {
+	return int32(goValue.Level), nil
+}
@@ -0,0 +1,8 @@
+package object
+
+// CustomTypes stores fields of custom types, see converters.skip.go for the types and their converters
+type CustomTypes struct {
+	Id       uint64
+	Color    Color    `objectbox:"converter:colorString type:string"`
+	Priority Priority `objectbox:"converter:priorityInt type:int32"`
+}
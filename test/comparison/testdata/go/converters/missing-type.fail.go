@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for converters/missing-type.fail.go: type annotation has to be specified when using converters on property Color found in MissingType
+
+type MissingType struct {
+	Id    uint64
+	Color Color `objectbox:"converter:colorString"`
+}
@@ -0,0 +1,12 @@
+package object
+
+// Dates stores timestamps in both signed and unsigned 64-bit integers
+type Dates struct {
+	Id              uint64
+	Signed          int64   `objectbox:"date"`
+	Unsigned        uint64  `objectbox:"date"`
+	SignedNano      int64   `objectbox:"date-nano"`
+	UnsignedNano    uint64  `objectbox:"date-nano"`
+	SignedPointer   *int64  `objectbox:"date"`
+	UnsignedPointer *uint64 `objectbox:"date"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for date/nano-int32.fail.go: invalid underlying type 'Int' for date/date-nano field; expecting long (signed or unsigned) on property Date found in Int32DateNano
+
+type Int32DateNano struct {
+	Id   uint64
+	Date uint32 `objectbox:"date-nano"`
+}
@@ -0,0 +1,10 @@
+package object
+
+const defaultName = "name"
+
+// ERROR = can't prepare bindings for default/constant.fail.go: default value defaultName = "name" doesn't fit the property type int on property Value found in InvalidConstant
+
+type InvalidConstant struct {
+	Id    uint64
+	Value int `objectbox:"default:defaultName"`
+}
@@ -0,0 +1,22 @@
+package object
+
+type Timeout int64
+
+const DefaultTimeout Timeout = 30
+const DefaultRatio = 0.5
+const defaultLevel = 3
+const DefaultEnabled = true
+
+type WithDefaults struct {
+	Id       uint64
+	Count    int     `objectbox:"default:42"`
+	Negative int16   `objectbox:"default:-7"`
+	Small    uint8   `objectbox:"default:255"`
+	Ratio    float64 `objectbox:"default:1.5"`
+	Enabled  bool    `objectbox:"default:true"`
+	Timeout  Timeout `objectbox:"default:DefaultTimeout"`
+	Level    uint32  `objectbox:"default:defaultLevel"`
+	Factor   float32 `objectbox:"default:DefaultRatio"`
+	Active   bool    `objectbox:"default:DefaultEnabled"`
+	Plain    int64
+}
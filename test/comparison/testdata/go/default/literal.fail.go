@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for default/literal.fail.go: default value 256 doesn't fit the property type uint8 on property Value found in InvalidLiteral
+
+type InvalidLiteral struct {
+	Id    uint64
+	Value uint8 `objectbox:"default:256"`
+}
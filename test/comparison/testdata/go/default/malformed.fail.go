@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for default/malformed.fail.go: default value 4x2 is neither a literal nor a package-level constant on property Value found in MalformedDefault
+
+type MalformedDefault struct {
+	Id    uint64
+	Value int32 `objectbox:"default:4x2"`
+}
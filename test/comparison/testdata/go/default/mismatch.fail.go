@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for default/mismatch.fail.go: default value true doesn't fit the property type float32 on property Value found in MismatchedDefault
+
+type MismatchedDefault struct {
+	Id    uint64
+	Value float32 `objectbox:"default:true"`
+}
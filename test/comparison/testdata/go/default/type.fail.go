@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for default/type.fail.go: default annotation is only supported on numeric and bool (non-pointer) properties without a converter on property Value found in UnsupportedDefault
+
+type UnsupportedDefault struct {
+	Id    uint64
+	Value string `objectbox:"default:text"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for default/unknown.fail.go: default value missingConstant is neither a literal nor a package-level constant on property Value found in UnknownConstant
+
+type UnknownConstant struct {
+	Id    uint64
+	Value int `objectbox:"default:missingConstant"`
+}
@@ -0,0 +1,12 @@
+package object
+
+// ERROR = can't prepare bindings for entity-name/duplicate.fail.go: entities First and Second have the same name in the database: Second
+
+// `objectbox:"name:Second"`
+type First struct {
+	Id uint64
+}
+
+type Second struct {
+	Id uint64
+}
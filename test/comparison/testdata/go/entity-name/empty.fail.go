@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for entity-name/empty.fail.go: name annotation value must not be empty - it's the entity name in DB on entity Empty
+
+// `objectbox:"name:"`
+type Empty struct {
+	Id uint64
+}
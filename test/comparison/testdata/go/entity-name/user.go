@@ -0,0 +1,14 @@
+package object
+
+// User is stored as "accounts" in the database while the Go type keeps its name
+// `objectbox:"name:accounts"`
+type User struct {
+	Id    uint64
+	Email string
+}
+
+type Session struct {
+	Id    uint64
+	Token string
+	User  *User `objectbox:"link"`
+}
@@ -0,0 +1,27 @@
+package object
+
+import "time"
+
+//go:generate go run github.com/objectbox/objectbox-go/cmd/objectbox-gogen -equal
+
+type Compared struct {
+	Id       uint64
+	Name     string
+	Count    int32
+	Bytes    []byte
+	Strings  []string
+	Floats   []float32
+	Nullable *int64
+	Date     time.Time
+	Inner    Inner  `objectbox:"inline"`
+	Optional *Inner
+	Group    *Group `objectbox:"link"`
+	GroupVal Group  `objectbox:"link"`
+	Groups   []*Group
+	GroupsV  []Group
+}
+
+type Group struct {
+	Id   uint64
+	Name string
+}
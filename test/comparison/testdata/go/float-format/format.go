@@ -0,0 +1,11 @@
+package object
+
+type Amount float64
+
+type Formatted struct {
+	Id       uint64
+	Price    float64 `objectbox:"type:string converter(fmt=%.2f)"`
+	Ratio    float32 `objectbox:"type:string converter(fmt='%g')"`
+	Exact    Amount  `objectbox:"type:string converter(fmt=%+.4e)"`
+	Embedded Prices
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for float-format/text.fail.go: invalid float format 'EUR%.2f', expecting a single floating-point verb, e.g. %.2f on property Value found in InvalidText
+
+type InvalidText struct {
+	Id    uint64
+	Value float64 `objectbox:"type:string converter(fmt=EUR%.2f)"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for float-format/type.fail.go: converter with a fmt detail is only supported on float32 and float64 fields, found int64 on property Value found in InvalidType
+
+type InvalidType struct {
+	Id    uint64
+	Value int64 `objectbox:"type:string converter(fmt=%.2f)"`
+}
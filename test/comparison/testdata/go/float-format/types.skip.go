@@ -0,0 +1,7 @@
+package object
+
+// Prices is embedded in an entity, it's not an entity itself
+type Prices struct {
+	Net   float64 `objectbox:"type:string converter(fmt=%.3f)"`
+	Gross float64 `objectbox:"type:string converter(fmt=%.3f)"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for float-format/verb.fail.go: invalid float format '%d', expecting a single floating-point verb, e.g. %.2f on property Value found in InvalidVerb
+
+type InvalidVerb struct {
+	Id    uint64
+	Value float64 `objectbox:"type:string converter(fmt=%d)"`
+}
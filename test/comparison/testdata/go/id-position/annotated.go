@@ -0,0 +1,7 @@
+package object
+
+// IdAnnotatedLast has a custom-named ID as the last field
+type IdAnnotatedLast struct {
+	Name string
+	Key  uint64 `objectbox:"id"`
+}
@@ -0,0 +1,7 @@
+package object
+
+type IdLast struct {
+	Name  string
+	Value int64
+	Id    uint64
+}
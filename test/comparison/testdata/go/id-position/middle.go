@@ -0,0 +1,7 @@
+package object
+
+type IdMiddle struct {
+	Name  string
+	Id    uint64
+	Value int64
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for id/assignable.fail.go: assignable annotation is only valid on an ID property, use it together with `id` on property Name found in NotId
+
+type NotId struct {
+	Id   uint64
+	Name string `objectbox:"assignable"`
+}
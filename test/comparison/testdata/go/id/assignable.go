@@ -0,0 +1,7 @@
+package object
+
+// SelfAssignable uses self-assigned IDs; annotation order doesn't matter
+type SelfAssignable struct {
+	Id   uint64 `objectbox:"assignable,id"`
+	Name string
+}
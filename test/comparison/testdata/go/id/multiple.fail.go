@@ -1,6 +1,6 @@
 package object
 
-// ERROR = model finalization failed: entity Multiple 6:8325060299420976708 is invalid: multiple properties marked as ID: Id (1:7837839688282259259) and id2 (2:2518412263346885298)
+// ERROR = model finalization failed: entity Multiple 7:5617773211005988520 is invalid: multiple properties marked as ID: Id (1:2339563716805116249) and id2 (2:7144924247938981575)
 
 type Multiple struct {
 	Id  uint64 `objectbox:"id"`
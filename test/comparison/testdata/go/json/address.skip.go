@@ -0,0 +1,6 @@
+package object
+
+type Address struct {
+	Street string `json:"street"`
+	City   string `json:"city"`
+}
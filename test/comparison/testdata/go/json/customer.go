@@ -0,0 +1,10 @@
+package object
+
+// Customer stores nested values serialized as JSON in a single property each
+type Customer struct {
+	Id         uint64
+	Name       string
+	Address    Address           `objectbox:"type:json"`
+	Billing    *Address          `objectbox:"type:json"`
+	Attributes map[string]string `objectbox:"type:json"`
+}
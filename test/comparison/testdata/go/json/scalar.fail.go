@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for json/scalar.fail.go: type:json is only supported on struct and map fields, found int on property Count found in JsonScalar
+
+type JsonScalar struct {
+	Id    uint64
+	Count int `objectbox:"type:json"`
+}
@@ -0,0 +1,7 @@
+package main
+
+// Task is an entity declared in the main package
+type Task struct {
+	Id   uint64
+	Text string
+}
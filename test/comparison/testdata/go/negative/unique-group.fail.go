@@ -0,0 +1,9 @@
+package object
+
+// ERROR = can't prepare bindings for negative/unique-group.fail.go: unknown unique conflict strategy 'tenant', expecting replace or no value; note: composite (multi-property) unique constraints are not supported, an index always covers a single property on property TenantId found in CompositeUnique
+
+type CompositeUnique struct {
+	Id       uint64
+	TenantId uint64 `objectbox:"unique:tenant"`
+	Email    string `objectbox:"unique:tenant"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for negative/unknown-annotation.fail.go: unknown annotation 'uniqeu' on property Name found in Misspelled
+
+type Misspelled struct {
+	Id   uint64
+	Name string `objectbox:"uniqeu"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for negative/unknown-entity-annotation.fail.go: unknown annotation 'sycn' on entity MisspelledEntity
+
+// `objectbox:"sycn"`
+type MisspelledEntity struct {
+	Id uint64
+}
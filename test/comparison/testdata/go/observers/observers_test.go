@@ -0,0 +1,55 @@
+package object
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+)
+
+func TestSubscribe(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-observers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ob, err := objectbox.NewBuilder().Model(ObjectBoxModel()).Directory(dir).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ob.Close()
+	var box = BoxForTask(ob)
+
+	var received = make(chan []*Task, 10)
+	observer, err := box.Subscribe(func(objects []*Task, err error) {
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		received <- objects
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer observer.Unsubscribe()
+
+	if _, err := box.Put(&Task{Text: "observed"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// wait for the notification containing the put task, there may be earlier ones (e.g. the initial empty state)
+	var timeout = time.After(10 * time.Second)
+	for {
+		select {
+		case tasks := <-received:
+			if len(tasks) == 1 && tasks[0].Text == "observed" {
+				return
+			}
+		case <-timeout:
+			t.Fatal("the observer wasn't notified about the put task")
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package object
+
+//go:generate go run github.com/objectbox/objectbox-go/cmd/objectbox-gogen -observers
+
+type Task struct {
+	Id   uint64
+	Text string
+	Done bool
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for ordered/lazy.fail.go: ordered relations must be loaded eagerly on property Songs found in LazyPlaylist
+
+type LazyPlaylist struct {
+	Id    uint64
+	Songs []*Song `objectbox:"ordered lazy"`
+}
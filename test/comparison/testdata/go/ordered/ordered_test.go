@@ -0,0 +1,76 @@
+package object
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+)
+
+func openObjectBox(t *testing.T) (ob *objectbox.ObjectBox, closeFn func()) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-ordered")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ob, err = objectbox.NewBuilder().Model(ObjectBoxModel()).Directory(dir).Build()
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return ob, func() {
+		ob.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func songTitles(songs []*Song) []string {
+	var titles []string
+	for _, song := range songs {
+		titles = append(titles, song.Title)
+	}
+	return titles
+}
+
+func TestOrderedRelation(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForPlaylist(ob)
+
+	// songs get ascending IDs, the playlist order differs from them
+	var a, b, c = &Song{Title: "a"}, &Song{Title: "b"}, &Song{Title: "c"}
+	if _, err := BoxForSong(ob).PutMany([]*Song{a, b, c}); err != nil {
+		t.Fatal(err)
+	}
+
+	var playlist = &Playlist{Name: "mix", Songs: []*Song{c, a, b}}
+	id, err := box.Put(playlist)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertOrder := func(expected ...string) {
+		t.Helper()
+		read, err := box.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var actual = songTitles(read.Songs)
+		if len(actual) != len(expected) {
+			t.Fatalf("expected songs %v, got %v", expected, actual)
+		}
+		for i := range expected {
+			if actual[i] != expected[i] {
+				t.Fatalf("expected songs %v, got %v", expected, actual)
+			}
+		}
+	}
+	assertOrder("c", "a", "b")
+
+	// reordering the slice and putting the playlist again stores the new order
+	playlist.Songs = []*Song{b, c, a}
+	if _, err := box.Put(playlist); err != nil {
+		t.Fatal(err)
+	}
+	assertOrder("b", "c", "a")
+}
@@ -0,0 +1,19 @@
+package object
+
+// Playlist keeps its songs in the order they were added, the order is stored in an additional SongsOrder property
+type Playlist struct {
+	Id    uint64
+	Name  string
+	Songs []*Song `objectbox:"ordered"`
+	Tags  []Tag
+}
+
+type Song struct {
+	Id    uint64
+	Title string
+}
+
+type Tag struct {
+	Id   uint64
+	Name string
+}
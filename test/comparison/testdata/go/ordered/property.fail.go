@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for ordered/property.fail.go: ordered annotation is only supported on to-many relations on property Name found in OrderedProperty
+
+type OrderedProperty struct {
+	Id   uint64
+	Name string `objectbox:"ordered"`
+}
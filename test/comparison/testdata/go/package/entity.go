@@ -0,0 +1,8 @@
+package object
+
+//go:generate go run github.com/objectbox/objectbox-go/cmd/objectbox-gogen -package models
+
+type Entity struct {
+	Id   uint64
+	Name string
+}
@@ -0,0 +1,12 @@
+package object
+
+type Address struct {
+	Id     uint64
+	Street string
+}
+
+type User struct {
+	Id        uint64
+	Name      string
+	Addresses []*Address `objectbox:"lazy"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for relation-load/conflict.fail.go: lazy and eager annotations can't be used together on property Groups found in Conflicting
+
+type Conflicting struct {
+	Id     uint64
+	Groups []*Group `objectbox:"lazy eager"`
+}
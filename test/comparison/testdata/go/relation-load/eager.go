@@ -0,0 +1,16 @@
+package object
+
+type EagerDefault struct {
+	Id     uint64
+	Groups []*Group
+}
+
+type EagerOverridden struct {
+	Id     uint64
+	Groups []*Group `objectbox:"lazy"`
+}
+
+type Group struct {
+	Id   uint64
+	Name string
+}
@@ -0,0 +1,13 @@
+package object
+
+//go:generate go run github.com/objectbox/objectbox-go/cmd/objectbox-gogen -relation-load lazy
+
+type LazyDefault struct {
+	Id     uint64
+	Groups []*Group
+}
+
+type LazyOverridden struct {
+	Id     uint64
+	Groups []*Group `objectbox:"eager"`
+}
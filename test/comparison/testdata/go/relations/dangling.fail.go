@@ -0,0 +1,8 @@
+package object
+
+// ERROR = model finalization failed: relation TaskRelDangling.Group points to an unknown entity Missing
+
+type TaskRelDangling struct {
+	Id    uint64
+	Group uint64 `objectbox:"link:Missing"`
+}
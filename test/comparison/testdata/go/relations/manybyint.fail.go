@@ -0,0 +1,10 @@
+package object
+
+// ERROR = can't prepare bindings for relations/manybyint.fail.go: unsupported slice element type Status, expecting an entity or a pointer to an entity for a to-many relation on property Statuses found in TaskRelManyInt
+
+type Status int
+
+type TaskRelManyInt struct {
+	Id       uint64
+	Statuses []*Status
+}
@@ -0,0 +1,7 @@
+package object
+
+type A struct {
+	Id   uint64
+	Name string
+	//Removed string `objectbox:"index"` // removed, its index UID must be retired
+}
@@ -0,0 +1,11 @@
+package object
+
+// ERROR = relation cycle detected: Category.Parent (Category)
+
+// Category references its parent through a to-one relation declared as a pointer. Such a relation is always read
+// together with the object, which would recurse infinitely; declare ParentId uint64 `objectbox:"link=Category"` instead.
+type Category struct {
+	Id     uint64
+	Name   string
+	Parent *Category `objectbox:"link"`
+}
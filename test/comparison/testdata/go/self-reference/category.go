@@ -0,0 +1,10 @@
+package object
+
+// Category forms a tree using a to-one relation stored as a plain ID (not loaded automatically) and lazily loaded
+// to-many relations; eagerly loaded self-references are rejected as relation cycles.
+type Category struct {
+	Id       uint64
+	Name     string
+	ParentId uint64      `objectbox:"link=Category"`
+	Children []*Category `objectbox:"lazy"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = relation cycle detected: EagerCategory.Parent (EagerCategory)
+
+type EagerCategory struct {
+	Id     uint64
+	Parent *EagerCategory `objectbox:"link"`
+}
@@ -0,0 +1,7 @@
+package object
+
+// Inner is embedded in an entity, it's not an entity itself
+type Inner struct {
+	Data    []byte
+	Pointer *int64
+}
@@ -0,0 +1,24 @@
+package object
+
+import "time"
+
+//go:generate go run github.com/objectbox/objectbox-go/cmd/objectbox-gogen -stringer
+
+type Printed struct {
+	Id       uint64
+	Name     string
+	Count    int32
+	Bytes    []byte
+	Strings  []string
+	Nullable *string
+	Date     time.Time
+	Inner    Inner `objectbox:"inline"`
+	Optional *Inner
+	Group    *Group `objectbox:"link"`
+	Groups   []*Group
+}
+
+type Group struct {
+	Id   uint64
+	Name string
+}
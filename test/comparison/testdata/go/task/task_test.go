@@ -0,0 +1,248 @@
+package object
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+)
+
+func openObjectBox(t *testing.T) (ob *objectbox.ObjectBox, closeFn func()) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-task")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ob, err = objectbox.NewBuilder().Model(ObjectBoxModel()).Directory(dir).Build()
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return ob, func() {
+		ob.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func putTasks(t *testing.T, box *TaskBox, count int) []*Task {
+	var tasks []*Task
+	for i := 1; i <= count; i++ {
+		tasks = append(tasks, &Task{Uid: "uid-" + strconv.Itoa(i), Text: "task " + strconv.Itoa(i), Date: uint64(i)})
+	}
+	if _, err := box.PutMany(tasks); err != nil {
+		t.Fatal(err)
+	}
+	return tasks
+}
+
+func TestQueryParams(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForTask(ob)
+	putTasks(t, box, 3)
+
+	var query = box.Query(Task_.Date.GreaterThan(0).As(objectbox.Alias("date")),
+		Task_.Text.NotEquals("", true).As(objectbox.Alias("text")))
+	defer query.Close()
+
+	if err := query.SetParamInt("date", 1); err != nil {
+		t.Fatal(err)
+	}
+	if tasks, err := query.Find(); err != nil {
+		t.Fatal(err)
+	} else if len(tasks) != 2 {
+		t.Fatalf("expected 2 tasks with date > 1, found %d", len(tasks))
+	}
+
+	if err := query.SetParamString("text", "task 2"); err != nil {
+		t.Fatal(err)
+	}
+	if tasks, err := query.Find(); err != nil {
+		t.Fatal(err)
+	} else if len(tasks) != 1 || tasks[0].Text != "task 3" {
+		t.Fatalf("expected only task 3, found %v", tasks)
+	}
+}
+
+func TestFindWithContext(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForTask(ob)
+	putTasks(t, box, 3)
+
+	var query = box.Query()
+	defer query.Close()
+
+	if tasks, err := query.FindWithContext(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if len(tasks) != 3 {
+		t.Fatalf("expected 3 tasks, found %d", len(tasks))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if tasks, err := query.FindWithContext(ctx); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	} else if tasks != nil {
+		t.Fatalf("expected no tasks on a canceled context, got %v", tasks)
+	}
+}
+
+func TestForEach(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForTask(ob)
+	putTasks(t, box, 3)
+
+	var visited int
+	if err := box.ForEach(func(task *Task) error {
+		visited++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	} else if visited != 3 {
+		t.Fatalf("expected 3 visited tasks, got %d", visited)
+	}
+
+	var stop = errors.New("stop")
+	visited = 0
+	if err := box.ForEach(func(task *Task) error {
+		visited++
+		return stop
+	}); err != stop {
+		t.Fatalf("expected the visitor's error, got %v", err)
+	} else if visited != 1 {
+		t.Fatalf("expected ForEach to stop after the first task, visited %d", visited)
+	}
+}
+
+func TestRemoveManyWithErrors(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForTask(ob)
+	var tasks = putTasks(t, box, 3)
+
+	if err := box.Remove(tasks[1]); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, errs := box.RemoveManyWithErrors(tasks[0], tasks[1], &Task{}, tasks[2])
+	if removed != 2 {
+		t.Fatalf("expected 2 removed tasks, got %d", removed)
+	}
+	if len(errs) != 4 || errs[0] != nil || errs[1] == nil || errs[2] == nil || errs[3] != nil {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	if count, err := box.RemoveAllCount(); err != nil {
+		t.Fatal(err)
+	} else if count != 0 {
+		t.Fatalf("expected an empty box, removed %d tasks", count)
+	}
+}
+
+func TestRemoveAllCount(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForTask(ob)
+	putTasks(t, box, 3)
+
+	if count, err := box.RemoveAllCount(); err != nil {
+		t.Fatal(err)
+	} else if count != 3 {
+		t.Fatalf("expected 3 removed tasks, got %d", count)
+	}
+	if isEmpty, err := box.IsEmpty(); err != nil {
+		t.Fatal(err)
+	} else if !isEmpty {
+		t.Fatal("expected an empty box")
+	}
+}
+
+func TestCheckRelations(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForTask(ob)
+
+	groupId, err := BoxForGroup(ob).Put(&Group{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var valid = &Task{Uid: "valid", GroupId: groupId}
+	var dangling = &Task{Uid: "dangling", GroupId: groupId + 100}
+	var unset = &Task{Uid: "unset"}
+	if _, err := box.PutMany([]*Task{valid, dangling, unset}); err != nil {
+		t.Fatal(err)
+	}
+
+	relationErrors, err := box.CheckRelations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(relationErrors) != 1 || relationErrors[0].SourceId != dangling.Id || relationErrors[0].Property != "GroupId" {
+		t.Fatalf("expected a single dangling relation of task %d, got %v", dangling.Id, relationErrors)
+	}
+}
+
+func TestPutAsyncCallback(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForTask(ob)
+
+	type result struct {
+		id  uint64
+		err error
+	}
+	var results = make(chan result, 3)
+	var tasks = []*Task{{Uid: "a"}, {Uid: "b"}, {Uid: "c"}}
+	for _, task := range tasks {
+		box.PutAsyncCallback(task, func(id uint64, err error) {
+			results <- result{id, err}
+		})
+	}
+
+	for range tasks {
+		select {
+		case r := <-results:
+			if r.err != nil {
+				t.Fatal(r.err)
+			}
+			if task, err := box.Get(r.id); err != nil {
+				t.Fatal(err)
+			} else if task == nil {
+				t.Fatalf("task %d reported as stored but not found", r.id)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("callback not called")
+		}
+	}
+}
+
+func TestPutAsyncCallbackStringId(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForTaskStringByValue(ob)
+
+	var results = make(chan error, 1)
+	var object = &TaskStringByValue{Name: "string ID"}
+	box.PutAsyncCallback(object, func(id string, err error) {
+		if err == nil && id != object.Id {
+			err = errors.New("callback received ID " + id + ", object has " + object.Id)
+		}
+		results <- err
+	})
+
+	select {
+	case err := <-results:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("callback not called")
+	}
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for transient/combined.fail.go: to ignore the property, use only `objectbox:"transient"` as an annotation on property Preview found in NoteInvalid
+
+type NoteInvalid struct {
+	Id      uint64
+	Preview string `objectbox:"transient index"`
+}
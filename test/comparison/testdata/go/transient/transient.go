@@ -0,0 +1,9 @@
+package object
+
+// Note stores only some of its fields, the others are skipped using either of the equivalent annotations
+type Note struct {
+	Id      uint64
+	Text    string
+	Cache   string `objectbox:"-"`
+	Preview string `objectbox:"transient"`
+}
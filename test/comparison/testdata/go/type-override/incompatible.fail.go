@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for type-override/incompatible.fail.go: type int32 is incompatible with the field type string, use a converter to store it as a different kind on property Text found in Incompatible
+
+type Incompatible struct {
+	Id   uint64
+	Text string `objectbox:"type:int32"`
+}
@@ -0,0 +1,13 @@
+package object
+
+type Level int
+
+// TypeOverride stores fields using a different width than declared
+type TypeOverride struct {
+	Id      uint64
+	Count   int     `objectbox:"type:int32"`
+	Small   uint16  `objectbox:"type:uint64"`
+	Level   Level   `objectbox:"type:int8"`
+	Ratio   float64 `objectbox:"type:float32"`
+	Default int64   `objectbox:"type:int64"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for unique/strategy.fail.go: unknown unique conflict strategy 'ignore', expecting replace or no value; note: composite (multi-property) unique constraints are not supported, an index always covers a single property on property Login found in UnknownStrategy
+
+type UnknownStrategy struct {
+	Id    uint64
+	Login string `objectbox:"unique:ignore"`
+}
@@ -0,0 +1,9 @@
+package object
+
+// User shows the two strategies of resolving a unique constraint violation on put
+type User struct {
+	Id     uint64
+	Email  string `objectbox:"unique"`         // put fails on a conflict (the default)
+	Login  string `objectbox:"unique:replace"` // the conflicting object is replaced
+	Number uint32 `objectbox:"unique"`         // integer properties are matched by PutAllUniqueNumber() as well
+}
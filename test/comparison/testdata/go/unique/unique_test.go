@@ -0,0 +1,93 @@
+package object
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+)
+
+func openObjectBox(t *testing.T) (ob *objectbox.ObjectBox, closeFn func()) {
+	dir, err := ioutil.TempDir("", "objectbox-generator-unique")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ob, err = objectbox.NewBuilder().Model(ObjectBoxModel()).Directory(dir).Build()
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return ob, func() {
+		ob.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestPutAllUniqueEmail(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForUser(ob)
+
+	storedId, err := box.Put(&User{Email: "a@example.com", Login: "a", Number: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first object matches the stored one, the last two are duplicates within the slice
+	created, updated, err := box.PutAllUniqueEmail([]*User{
+		{Email: "a@example.com", Login: "a2", Number: 2},
+		{Email: "b@example.com", Login: "b", Number: 3},
+		{Email: "b@example.com", Login: "b2", Number: 4},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated) != 1 || updated[0] != storedId {
+		t.Fatalf("expected the stored user %d to be updated, got %v", storedId, updated)
+	}
+	if len(created) != 1 {
+		t.Fatalf("expected a single created user, got %v", created)
+	}
+
+	if count, err := box.Count(); err != nil {
+		t.Fatal(err)
+	} else if count != 2 {
+		t.Fatalf("expected 2 stored users, found %d", count)
+	}
+
+	// the last of the duplicates is stored
+	if user, err := box.Get(created[0]); err != nil {
+		t.Fatal(err)
+	} else if user.Login != "b2" {
+		t.Fatalf("expected the last duplicate to be stored, found %v", user)
+	}
+	if user, err := box.Get(storedId); err != nil {
+		t.Fatal(err)
+	} else if user.Login != "a2" {
+		t.Fatalf("expected the stored user to be replaced, found %v", user)
+	}
+}
+
+func TestPutAllUniqueNumber(t *testing.T) {
+	ob, closeFn := openObjectBox(t)
+	defer closeFn()
+	var box = BoxForUser(ob)
+
+	storedId, err := box.Put(&User{Email: "a@example.com", Login: "a", Number: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, updated, err := box.PutAllUniqueNumber([]*User{
+		{Email: "a2@example.com", Login: "a2", Number: 1},
+		{Email: "b@example.com", Login: "b", Number: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated) != 1 || updated[0] != storedId || len(created) != 1 {
+		t.Fatalf("expected one updated (%d) and one created user, got updated %v, created %v",
+			storedId, updated, created)
+	}
+}
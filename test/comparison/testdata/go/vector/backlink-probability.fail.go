@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for vector/backlink-probability.fail.go: Annotation 'hnsw-reparation-backlink-probability' value out of range: must be between 0.0 and 1.0 on property Vector found in BacklinkProbability
+
+type BacklinkProbability struct {
+	Id     uint64
+	Vector []float32 `objectbox:"index:hnsw hnsw-reparation-backlink-probability:1.5"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for vector/cache-hint.fail.go: Annotation 'hnsw-vector-cache-hint-size-kb' value type mismatch: strconv.ParseUint: parsing "-1": invalid syntax on property Vector found in CacheHint
+
+type CacheHint struct {
+	Id     uint64
+	Vector []float32 `objectbox:"index:hnsw hnsw-vector-cache-hint-size-kb:-1"`
+}
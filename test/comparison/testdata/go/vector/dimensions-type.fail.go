@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for vector/dimensions-type.fail.go: Annotation 'hnsw-dimensions' value type mismatch: strconv.ParseUint: parsing "abc": invalid syntax on property Vector found in DimensionsType
+
+type DimensionsType struct {
+	Id     uint64
+	Vector []float32 `objectbox:"index:hnsw hnsw-dimensions:abc"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for vector/dimensions-zero.fail.go: Annotation 'hnsw-dimensions' value out of range: must be at least 1 on property Vector found in DimensionsZero
+
+type DimensionsZero struct {
+	Id     uint64
+	Vector []float32 `objectbox:"index:hnsw hnsw-dimensions:0"`
+}
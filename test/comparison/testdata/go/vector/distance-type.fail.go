@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for vector/distance-type.fail.go: Annotation 'hnsw-distance-type' value type mismatch: must be one of 'Unknown', 'Euclidean', 'Cosine', 'DotProduct', 'DotProductNonNormalized' on property Vector found in DistanceType
+
+type DistanceType struct {
+	Id     uint64
+	Vector []float32 `objectbox:"index:hnsw hnsw-distance-type:manhattan"`
+}
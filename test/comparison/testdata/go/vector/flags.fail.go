@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for vector/flags.fail.go: HNSW Flag unknown: 'Verbose' (Available flags: DebugLogs, DebugLogsDetailed, None, ReparationLimitCandidates, VectorCacheSimdPaddingOff) on property Vector found in Flags
+
+type Flags struct {
+	Id     uint64
+	Vector []float32 `objectbox:"index:hnsw hnsw-flags:Verbose"`
+}
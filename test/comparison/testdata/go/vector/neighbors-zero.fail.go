@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for vector/neighbors-zero.fail.go: Annotation 'hnsw-neighbors-per-node' value out of range: must be at least 1 on property Vector found in NeighborsZero
+
+type NeighborsZero struct {
+	Id     uint64
+	Vector []float32 `objectbox:"index:hnsw hnsw-neighbors-per-node:0"`
+}
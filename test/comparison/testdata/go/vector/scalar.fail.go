@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for vector/scalar.fail.go: index type 'hnsw' only supported for float vectors on property Score found in ScalarHnsw
+
+type ScalarHnsw struct {
+	Id    uint64
+	Score float32 `objectbox:"index:hnsw hnsw-dimensions:1"`
+}
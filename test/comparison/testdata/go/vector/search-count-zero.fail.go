@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for vector/search-count-zero.fail.go: Annotation 'hnsw-indexing-search-count' value out of range: must be at least 1 on property Vector found in SearchCountZero
+
+type SearchCountZero struct {
+	Id     uint64
+	Vector []float32 `objectbox:"index:hnsw hnsw-indexing-search-count:0"`
+}
@@ -0,0 +1,16 @@
+package object
+
+// Document stores a 128-dimensional embedding indexed for nearest-neighbor search
+type Document struct {
+	Id        uint64
+	Text      string
+	Embedding []float32 `objectbox:"index:hnsw hnsw-dimensions:128"`
+	Raw       []float32 // not indexed
+}
+
+// Tuned uses a fully configured HNSW index
+type Tuned struct {
+	Id       uint64
+	Cosine   []float32 `objectbox:"index:hnsw hnsw-dimensions:384 hnsw-neighbors-per-node:30 hnsw-distance-type:cosine"`
+	Detailed []float32 `objectbox:"index:hnsw hnsw-dimensions:2 hnsw-distance-type:DotProductNonNormalized hnsw-indexing-search-count:50 hnsw-reparation-backlink-probability:0.5 hnsw-vector-cache-hint-size-kb:1024 hnsw-flags:DebugLogs|VectorCacheSimdPaddingOff"`
+}
@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for vector/without-index.fail.go: The HNSW annotation 'hnsw-dimensions' is only allowed after an 'index' annotation set to 'hnsw'. on property Vector found in WithoutIndex
+
+type WithoutIndex struct {
+	Id     uint64
+	Vector []float32 `objectbox:"hnsw-dimensions:3"`
+}